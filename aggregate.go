@@ -0,0 +1,166 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// CurrencyMismatchError is returned by aggregation helpers for the first Money
+// whose currency differs from the currency of the first one. Operations on two Money
+// like Add, Equals or Compare return it with Index 1 for the other Money
+type CurrencyMismatchError struct {
+	Index    int
+	Expected string
+	Actual   string
+}
+
+func (e *CurrencyMismatchError) Error() string {
+	return fmt.Sprintf("money: currency %s at index %d doesn't match %s", e.Actual, e.Index, e.Expected)
+}
+
+// errNoMoney is returned by aggregation helpers for empty input
+var errNoMoney = errors.New("money: no money given")
+
+// sameCurrency returns currency shared by all Money,
+// *CurrencyMismatchError is returned for the first Money of other currency
+func sameCurrency(ms []*Money) (*Currency, error) {
+	if len(ms) == 0 {
+		return nil, errNoMoney
+	}
+
+	var c *Currency
+	for i, m := range ms {
+		if m == nil || m.currency == nil {
+			return nil, fmt.Errorf("money: money at index %d has no currency", i)
+		}
+		if c == nil {
+			c = m.currency
+			continue
+		}
+		if !c.equals(m.currency) {
+			return nil, &CurrencyMismatchError{Index: i, Expected: c.Code, Actual: m.currency.Code}
+		}
+	}
+
+	return c, nil
+}
+
+// Sum returns sum of Money of the same currency
+func Sum(ms ...*Money) (*Money, error) {
+	if _, err := sameCurrency(ms); err != nil {
+		return nil, err
+	}
+
+	sum := ms[0].amount
+	for _, m := range ms[1:] {
+		sum = sum.Add(m.amount)
+	}
+
+	return &Money{amount: sum, currency: ms[0].currency, rounding: ms[0].rounding}, nil
+}
+
+// Min returns the smallest Money of the same currency, the first one if there are several
+func Min(ms ...*Money) (*Money, error) {
+	return pick(ms, func(m, best *Money) bool { return m.amount.LessThan(best.amount) })
+}
+
+// Max returns the largest Money of the same currency, the first one if there are several
+func Max(ms ...*Money) (*Money, error) {
+	return pick(ms, func(m, best *Money) bool { return m.amount.GreaterThan(best.amount) })
+}
+
+func pick(ms []*Money, better func(m, best *Money) bool) (*Money, error) {
+	if _, err := sameCurrency(ms); err != nil {
+		return nil, err
+	}
+
+	best := ms[0]
+	for _, m := range ms[1:] {
+		if better(m, best) {
+			best = m
+		}
+	}
+
+	return best, nil
+}
+
+// Average returns average of Money of the same currency rounded by currency Fraction
+// using the rounding mode, zero mode stands for RoundingMode of the first Money.
+// Remainder is the sum minus average multiplied by the number of Money,
+// so no pennies are lost when remainder is distributed separately
+func Average(mode RoundingMode, ms ...*Money) (avg *Money, remainder *Money, err error) {
+	sum, err := Sum(ms...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if mode == 0 {
+		mode = ms[0].RoundingMode()
+	}
+
+	n := decimal.New(int64(len(ms)), 0)
+	amount, err := divide(sum.amount, n, int32(sum.currency.Fraction), mode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	avg = &Money{amount: amount, currency: sum.currency, rounding: sum.rounding}
+	remainder = &Money{amount: sum.amount.Sub(amount.Mul(n)), currency: sum.currency, rounding: sum.rounding}
+
+	return avg, remainder, nil
+}
+
+// Compare compares Money of the same currency and returns -1 if Money is less than the other,
+// 0 if they are equal and 1 if it is greater. *CurrencyMismatchError with Index 1
+// is returned if the other Money has different currency
+func (m *Money) Compare(om *Money) (int, error) {
+	if _, err := sameCurrency([]*Money{m, om}); err != nil {
+		return 0, err
+	}
+
+	return m.amount.Cmp(om.amount), nil
+}
+
+// Comparator checks that all Money have the same currency and returns comparison function
+// of their amounts compatible with slices.SortFunc and sort.Slice helpers
+func Comparator(ms []*Money) (func(a, b *Money) int, error) {
+	if len(ms) == 0 {
+		return compareAmounts, nil
+	}
+	if _, err := sameCurrency(ms); err != nil {
+		return nil, err
+	}
+
+	return compareAmounts, nil
+}
+
+func compareAmounts(a, b *Money) int {
+	return a.amount.Cmp(b.amount)
+}
+
+// SortAscending sorts Money of the same currency from the smallest to the largest one,
+// equal Money keep their order. Slice is left unchanged if currencies differ
+func SortAscending(ms []*Money) error {
+	return sortMoney(ms, func(a, b *Money) bool { return a.amount.LessThan(b.amount) })
+}
+
+// SortDescending sorts Money of the same currency from the largest to the smallest one,
+// equal Money keep their order. Slice is left unchanged if currencies differ
+func SortDescending(ms []*Money) error {
+	return sortMoney(ms, func(a, b *Money) bool { return a.amount.GreaterThan(b.amount) })
+}
+
+func sortMoney(ms []*Money, less func(a, b *Money) bool) error {
+	if len(ms) == 0 {
+		return nil
+	}
+	if _, err := sameCurrency(ms); err != nil {
+		return err
+	}
+
+	sort.SliceStable(ms, func(i, j int) bool { return less(ms[i], ms[j]) })
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package money_test
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/amanbolat/go-money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSum(t *testing.T) {
+	sum, err := money.Sum(money.New(100, "EUR"), money.New(250, "EUR"), money.New(-50, "EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, "3.00 EUR", sum.String())
+
+	_, err = money.Sum()
+	assert.Error(t, err)
+
+	_, err = money.Sum(money.New(100, "EUR"), money.New(100, "EUR"), money.New(100, "USD"), money.New(100, "GBP"))
+	var mismatch *money.CurrencyMismatchError
+	require.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, 2, mismatch.Index)
+	assert.Equal(t, "EUR", mismatch.Expected)
+	assert.Equal(t, "USD", mismatch.Actual)
+	assert.Equal(t, "money: currency USD at index 2 doesn't match EUR", err.Error())
+
+	_, err = money.Sum(money.New(100, "EUR"), nil)
+	assert.EqualError(t, err, "money: money at index 1 has no currency")
+}
+
+func TestMinMax(t *testing.T) {
+	ms := []*money.Money{money.New(300, "USD"), money.New(-100, "USD"), money.New(500, "USD"), money.New(-100, "USD")}
+
+	min, err := money.Min(ms...)
+	require.NoError(t, err)
+	assert.Same(t, ms[1], min)
+
+	max, err := money.Max(ms...)
+	require.NoError(t, err)
+	assert.Same(t, ms[2], max)
+
+	_, err = money.Max(money.New(1, "USD"), money.New(1, "JPY"))
+	var mismatch *money.CurrencyMismatchError
+	assert.True(t, errors.As(err, &mismatch))
+
+	_, err = money.Min()
+	assert.Error(t, err)
+}
+
+func TestAverage(t *testing.T) {
+	tcs := []struct {
+		amounts   []int64
+		mode      money.RoundingMode
+		avg       string
+		remainder string
+	}{
+		{[]int64{100, 200, 300}, 0, "2.00 USD", "0.00 USD"},
+		{[]int64{100, 100, 101}, 0, "1.00 USD", "0.01 USD"},
+		{[]int64{100, 100, 101}, money.Up, "1.01 USD", "-0.02 USD"},
+		{[]int64{1, 2}, money.HalfEven, "0.02 USD", "-0.01 USD"},
+		{[]int64{1, 2}, money.HalfDown, "0.01 USD", "0.01 USD"},
+		{[]int64{-1, -2}, 0, "-0.02 USD", "0.01 USD"},
+	}
+
+	for _, tc := range tcs {
+		var ms []*money.Money
+		for _, a := range tc.amounts {
+			ms = append(ms, money.New(a, "USD"))
+		}
+
+		avg, rem, err := money.Average(tc.mode, ms...)
+		require.NoError(t, err)
+		assert.Equal(t, tc.avg, avg.String(), "%v %s", tc.amounts, tc.mode)
+		assert.Equal(t, tc.remainder, rem.String(), "%v %s", tc.amounts, tc.mode)
+	}
+
+	_, _, err := money.Average(money.Unnecessary, money.New(1, "USD"), money.New(2, "USD"))
+	assert.True(t, errors.Is(err, money.ErrRoundingNecessary))
+}
+
+func TestMoney_Compare(t *testing.T) {
+	tcs := []struct {
+		a, b     int64
+		expected int
+	}{
+		{100, 200, -1},
+		{200, 200, 0},
+		{300, 200, 1},
+	}
+
+	for _, tc := range tcs {
+		r, err := money.New(tc.a, "USD").Compare(money.New(tc.b, "USD"))
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, r)
+	}
+
+	_, err := money.New(100, "USD").Compare(money.New(100, "EUR"))
+	var mismatch *money.CurrencyMismatchError
+	if assert.True(t, errors.As(err, &mismatch), "%v", err) {
+		assert.Equal(t, money.CurrencyMismatchError{Index: 1, Expected: "USD", Actual: "EUR"}, *mismatch)
+	}
+
+	_, err = money.New(100, "USD").Compare(nil)
+	assert.Error(t, err)
+}
+
+func TestMoney_CurrencyMismatch(t *testing.T) {
+	usd, eur := money.New(100, "USD"), money.New(100, "EUR")
+
+	_, addErr := usd.Add(eur)
+	_, equalsErr := usd.Equals(eur)
+	_, lessErr := usd.LessThan(eur)
+
+	for _, err := range []error{addErr, equalsErr, lessErr} {
+		var mismatch *money.CurrencyMismatchError
+		if assert.True(t, errors.As(err, &mismatch), "%v", err) {
+			assert.Equal(t, money.CurrencyMismatchError{Index: 1, Expected: "USD", Actual: "EUR"}, *mismatch)
+		}
+	}
+}
+
+func TestSort(t *testing.T) {
+	ms := []*money.Money{money.New(300, "USD"), money.New(-100, "USD"), money.New(500, "USD"), money.New(0, "USD")}
+
+	require.NoError(t, money.SortAscending(ms))
+	assert.Equal(t, []string{"-1.00 USD", "0.00 USD", "3.00 USD", "5.00 USD"}, moneyStrings(ms))
+
+	require.NoError(t, money.SortDescending(ms))
+	assert.Equal(t, []string{"5.00 USD", "3.00 USD", "0.00 USD", "-1.00 USD"}, moneyStrings(ms))
+
+	mixed := []*money.Money{money.New(300, "USD"), money.New(100, "USD"), money.New(200, "EUR")}
+	err := money.SortAscending(mixed)
+	var mismatch *money.CurrencyMismatchError
+	require.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, 2, mismatch.Index)
+	assert.Equal(t, []string{"3.00 USD", "1.00 USD", "2.00 EUR"}, moneyStrings(mixed))
+
+	assert.NoError(t, money.SortAscending(nil))
+}
+
+func TestComparator(t *testing.T) {
+	ms := []*money.Money{money.New(300, "USD"), money.New(-100, "USD"), money.New(500, "USD")}
+
+	cmp, err := money.Comparator(ms)
+	require.NoError(t, err)
+	sort.Slice(ms, func(i, j int) bool { return cmp(ms[i], ms[j]) < 0 })
+	assert.Equal(t, []string{"-1.00 USD", "3.00 USD", "5.00 USD"}, moneyStrings(ms))
+	assert.Equal(t, 0, cmp(money.New(1, "USD"), money.New(1, "USD")))
+
+	_, err = money.Comparator([]*money.Money{money.New(1, "USD"), money.New(1, "EUR")})
+	var mismatch *money.CurrencyMismatchError
+	assert.True(t, errors.As(err, &mismatch))
+}
+
+func moneyStrings(ms []*money.Money) []string {
+	s := make([]string, len(ms))
+	for i, m := range ms {
+		s[i] = m.String()
+	}
+
+	return s
+}
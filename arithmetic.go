@@ -0,0 +1,85 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Precision selects how many digits results of decimal operations keep
+type Precision int
+
+const (
+	// CurrencyPrecision rounds result by currency Fraction
+	CurrencyPrecision Precision = iota
+	// ExtendedPrecision keeps all digits of multiplication result,
+	// division result is rounded to decimal.DivisionPrecision digits
+	ExtendedPrecision
+)
+
+// MultiplyDecimal returns new Money struct with value multiplied by the factor, e.g. 0.85 discount factor.
+// Result is rounded according to the precision using the rounding mode,
+// zero mode stands for RoundingMode of Money
+func (m *Money) MultiplyDecimal(factor decimal.Decimal, mode RoundingMode, precision Precision) (*Money, error) {
+	return m.withPrecision(m.amount.Mul(factor), mode, precision)
+}
+
+// DivideDecimal returns new Money struct with value divided by the divisor.
+// Result is rounded according to the precision using the rounding mode,
+// zero mode stands for RoundingMode of Money
+func (m *Money) DivideDecimal(div decimal.Decimal, mode RoundingMode, precision Precision) (*Money, error) {
+	if mode == 0 {
+		mode = m.RoundingMode()
+	}
+
+	scale, err := m.scale(precision, int32(decimal.DivisionPrecision))
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := divide(m.amount, div, scale, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: amount, currency: m.currency, rounding: m.rounding}, nil
+}
+
+// Percent returns new Money struct with p percent of value, e.g. 7.25 gives tax of 7.25%.
+// Result is rounded like MultiplyDecimal does
+func (m *Money) Percent(p decimal.Decimal, mode RoundingMode, precision Precision) (*Money, error) {
+	return m.MultiplyDecimal(p.Shift(-2), mode, precision)
+}
+
+// ApplyRate returns new Money struct with value increased by the rate, e.g. 0.0725 tax rate
+// gives gross amount and -0.15 discount rate gives discounted amount.
+// Result is rounded like MultiplyDecimal does
+func (m *Money) ApplyRate(rate decimal.Decimal, mode RoundingMode, precision Precision) (*Money, error) {
+	return m.MultiplyDecimal(decimal.New(1, 0).Add(rate), mode, precision)
+}
+
+// withPrecision returns Money of the amount rounded according to the precision
+func (m *Money) withPrecision(amount decimal.Decimal, mode RoundingMode, precision Precision) (*Money, error) {
+	r := &Money{amount: amount, currency: m.currency, rounding: m.rounding}
+
+	switch precision {
+	case CurrencyPrecision:
+		return r.RoundWithMode(int32(m.currency.Fraction), mode)
+	case ExtendedPrecision:
+		return r, nil
+	}
+
+	return nil, fmt.Errorf("money: unknown precision %d", precision)
+}
+
+// scale returns number of fraction digits of the precision, extended is used for ExtendedPrecision
+func (m *Money) scale(precision Precision, extended int32) (int32, error) {
+	switch precision {
+	case CurrencyPrecision:
+		return int32(m.currency.Fraction), nil
+	case ExtendedPrecision:
+		return extended, nil
+	}
+
+	return 0, fmt.Errorf("money: unknown precision %d", precision)
+}
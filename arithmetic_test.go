@@ -0,0 +1,91 @@
+package money_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/amanbolat/go-money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoney_MultiplyDecimal(t *testing.T) {
+	tcs := []struct {
+		amount    int64
+		factor    string
+		mode      money.RoundingMode
+		precision money.Precision
+		expected  string
+	}{
+		{1999, "0.85", 0, money.CurrencyPrecision, "16.99 USD"},
+		{1999, "0.85", money.Up, money.CurrencyPrecision, "17.00 USD"},
+		{1999, "0.85", money.HalfEven, money.ExtendedPrecision, "16.9915 USD"},
+		{-1999, "0.85", money.Floor, money.CurrencyPrecision, "-17.00 USD"},
+		{100, "1.0845", money.Unnecessary, money.ExtendedPrecision, "1.0845 USD"},
+	}
+
+	for _, tc := range tcs {
+		r, err := money.New(tc.amount, "USD").MultiplyDecimal(mustDecimal(tc.factor), tc.mode, tc.precision)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, r.String(), "%d * %s", tc.amount, tc.factor)
+	}
+
+	_, err := money.New(1999, "USD").MultiplyDecimal(mustDecimal("0.85"), money.Unnecessary, money.CurrencyPrecision)
+	assert.True(t, errors.Is(err, money.ErrRoundingNecessary))
+
+	_, err = money.New(1999, "USD").MultiplyDecimal(mustDecimal("0.85"), 0, money.Precision(5))
+	assert.Error(t, err)
+}
+
+func TestMoney_DivideDecimal(t *testing.T) {
+	tcs := []struct {
+		amount    int64
+		div       string
+		mode      money.RoundingMode
+		precision money.Precision
+		expected  string
+	}{
+		{1000, "3", 0, money.CurrencyPrecision, "3.33 USD"},
+		{1000, "3", money.Up, money.CurrencyPrecision, "3.34 USD"},
+		{1000, "3", money.Down, money.ExtendedPrecision, "3.3333333333333333 USD"},
+		{1000, "3", money.Up, money.ExtendedPrecision, "3.3333333333333334 USD"},
+		{1000, "0.8", money.Unnecessary, money.ExtendedPrecision, "12.50 USD"},
+		{1, "2", money.HalfEven, money.CurrencyPrecision, "0.00 USD"},
+	}
+
+	for _, tc := range tcs {
+		r, err := money.New(tc.amount, "USD").DivideDecimal(mustDecimal(tc.div), tc.mode, tc.precision)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, r.String(), "%d / %s", tc.amount, tc.div)
+	}
+
+	_, err := money.New(1000, "USD").DivideDecimal(mustDecimal("0"), 0, money.CurrencyPrecision)
+	assert.Error(t, err)
+
+	_, err = money.New(1000, "USD").DivideDecimal(mustDecimal("3"), 0, money.Precision(5))
+	assert.Error(t, err)
+}
+
+func TestMoney_Percent(t *testing.T) {
+	r, err := money.New(4999, "USD").Percent(mustDecimal("7.25"), 0, money.CurrencyPrecision)
+	require.NoError(t, err)
+	assert.Equal(t, "3.62 USD", r.String())
+
+	r, err = money.New(4999, "USD").Percent(mustDecimal("7.25"), 0, money.ExtendedPrecision)
+	require.NoError(t, err)
+	assert.Equal(t, "3.624275 USD", r.String())
+
+	r, err = money.New(1000, "JPY").Percent(mustDecimal("8"), 0, money.CurrencyPrecision)
+	require.NoError(t, err)
+	assert.Equal(t, "80 JPY", r.String())
+}
+
+func TestMoney_ApplyRate(t *testing.T) {
+	r, err := money.New(4999, "USD").ApplyRate(mustDecimal("0.0725"), money.HalfEven, money.CurrencyPrecision)
+	require.NoError(t, err)
+	assert.Equal(t, "53.61 USD", r.String())
+
+	r, err = money.New(2000, "EUR").ApplyRate(mustDecimal("-0.15"), 0, money.CurrencyPrecision)
+	require.NoError(t, err)
+	assert.Equal(t, "17.00 EUR", r.String())
+}
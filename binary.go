@@ -0,0 +1,132 @@
+package money
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+// binaryVersion is the version of MarshalBinary format
+const binaryVersion = 1
+
+// errInvalidBinary is returned for malformed MarshalBinary data
+var errInvalidBinary = errors.New("money: invalid binary data")
+
+// MarshalBinary implements encoding.BinaryMarshaler. Money is written in a compact format:
+//
+//	version     1 byte
+//	code        1 byte length followed by currency code, empty for Money without currency
+//	exponent    varint
+//	coefficient uvarint zigzag(coefficient)<<1 if it fits 62 bits,
+//	            otherwise uvarint len<<2 | sign<<1 | 1 followed by big-endian magnitude
+//
+// Amount is stored exactly, e.g. 12.34 USD takes 8 bytes
+func (m Money) MarshalBinary() ([]byte, error) {
+	var code string
+	if m.currency != nil {
+		code = m.currency.Code
+	}
+	if len(code) > math.MaxUint8 {
+		return nil, fmt.Errorf("money: currency code %q is too long", code)
+	}
+
+	buf := make([]byte, 0, 2+len(code)+2*binary.MaxVarintLen64)
+	buf = append(buf, binaryVersion, byte(len(code)))
+	buf = append(buf, code...)
+	buf = binary.AppendVarint(buf, int64(m.amount.Exponent()))
+
+	coef := m.amount.Coefficient()
+	if coef.BitLen() < 62 {
+		c := coef.Int64()
+		return binary.AppendUvarint(buf, uint64(c<<1^c>>63)<<1), nil
+	}
+
+	var sign uint64
+	if coef.Sign() < 0 {
+		sign = 1
+	}
+	mag := new(big.Int).Abs(coef).Bytes()
+	buf = binary.AppendUvarint(buf, uint64(len(mag))<<2|sign<<1|1)
+
+	return append(buf, mag...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler reading MarshalBinary format.
+// Currency is resolved by the default registry like UnmarshalJSON does, amount is not rounded
+func (m *Money) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 {
+		return errInvalidBinary
+	}
+	if data[0] != binaryVersion {
+		return fmt.Errorf("money: unsupported binary version %d", data[0])
+	}
+
+	n := int(data[1])
+	data = data[2:]
+	if len(data) < n {
+		return errInvalidBinary
+	}
+	code := string(data[:n])
+	data = data[n:]
+
+	exp, n := binary.Varint(data)
+	if n <= 0 || exp < math.MinInt32 || exp > math.MaxInt32 {
+		return errInvalidBinary
+	}
+	data = data[n:]
+
+	h, n := binary.Uvarint(data)
+	if n <= 0 {
+		return errInvalidBinary
+	}
+	data = data[n:]
+
+	coef := new(big.Int)
+	if h&1 == 0 {
+		z := h >> 1
+		coef.SetInt64(int64(z>>1) ^ -int64(z&1))
+	} else {
+		size := h >> 2
+		if uint64(len(data)) < size {
+			return errInvalidBinary
+		}
+		coef.SetBytes(data[:size])
+		if h&2 != 0 {
+			coef.Neg(coef)
+		}
+		data = data[size:]
+	}
+	if len(data) != 0 {
+		return errInvalidBinary
+	}
+
+	var c *Currency
+	if code != "" {
+		var err error
+		if c, err = DefaultRegistry.resolve(code); err != nil {
+			return err
+		}
+	}
+
+	*m = Money{
+		amount:   decimal.NewFromBigInt(coef, int32(exp)),
+		currency: c,
+		rounding: DefaultRegistry.Rounding(),
+	}
+
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using MarshalBinary format
+func (m Money) GobEncode() ([]byte, error) {
+	return m.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using MarshalBinary format
+func (m *Money) GobDecode(data []byte) error {
+	return m.UnmarshalBinary(data)
+}
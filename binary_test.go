@@ -0,0 +1,178 @@
+package money_test
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"testing"
+
+	"github.com/amanbolat/go-money"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = money.Money{}
+	_ encoding.BinaryUnmarshaler = &money.Money{}
+	_ gob.GobEncoder             = money.Money{}
+	_ gob.GobDecoder             = &money.Money{}
+)
+
+// exactMoney returns Money with the amount which is not rounded by currency Fraction,
+// canonical text form keeps it exactly
+func exactMoney(amount, code string) money.Money {
+	var m money.Money
+	if err := m.UnmarshalText([]byte(amount + " " + code)); err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+func TestMoney_Binary(t *testing.T) {
+	tcs := []struct {
+		amount string
+		code   string
+		size   int
+	}{
+		{"12.34", "USD", 8},
+		{"-12.34", "USD", 8},
+		{"0", "JPY", 7},
+		{"1000000", "JPY", 10},
+		{"0.333333333333333333333333", "EUR", 17},
+		{"-123456789012345678901234567890.12", "EUR", 20},
+		{"1e-100", "BHD", 8},
+	}
+
+	for _, tc := range tcs {
+		m := exactMoney(tc.amount, tc.code)
+
+		data, err := m.MarshalBinary()
+		require.NoError(t, err)
+		assert.Len(t, data, tc.size, tc.amount)
+
+		var r money.Money
+		require.NoError(t, r.UnmarshalBinary(data))
+		assert.True(t, m.Amount().Equal(r.Amount()), "%s != %s", m.Amount(), r.Amount())
+		assert.Equal(t, m.Amount().Exponent(), r.Amount().Exponent())
+		assert.Equal(t, tc.code, r.Currency().Code)
+	}
+}
+
+func TestMoney_BinaryZero(t *testing.T) {
+	data, err := money.Money{}.MarshalBinary()
+	require.NoError(t, err)
+
+	var r money.Money
+	require.NoError(t, r.UnmarshalBinary(data))
+	assert.Nil(t, r.Currency())
+	assert.True(t, r.IsZero())
+}
+
+func TestMoney_UnmarshalBinaryErrors(t *testing.T) {
+	valid, err := money.New(1234, "USD").MarshalBinary()
+	require.NoError(t, err)
+
+	tcs := [][]byte{
+		nil,
+		{1},
+		{2, 0, 0, 0},
+		valid[:4],
+		valid[:len(valid)-1],
+		append(append([]byte{}, valid...), 0),
+		{1, 0, 0, 0x0b, 1},
+	}
+
+	for _, data := range tcs {
+		var m money.Money
+		assert.Error(t, m.UnmarshalBinary(data), "%v", data)
+	}
+}
+
+func TestMoney_BinaryCustomRegistry(t *testing.T) {
+	r := money.NewEmptyRegistry()
+	r.AddCurrency("TNT", "T$", "$1", ".", ",", 3)
+
+	data, err := r.NewFromDecimal(decimal.RequireFromString("1.234"), "TNT").MarshalBinary()
+	require.NoError(t, err)
+
+	var m money.Money
+	require.NoError(t, m.UnmarshalBinary(data))
+	assert.Equal(t, "TNT", m.Currency().Code)
+	assert.Equal(t, "1.234", m.Amount().String())
+
+	money.DefaultRegistry.SetStrict(true)
+	defer money.DefaultRegistry.SetStrict(false)
+	assert.Error(t, m.UnmarshalBinary(data))
+}
+
+func TestMoney_Gob(t *testing.T) {
+	type price struct {
+		Net   money.Money
+		Gross *money.Money
+	}
+
+	in := price{Net: *money.New(1000, "EUR"), Gross: money.New(1190, "EUR")}
+
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(in))
+
+	var out price
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&out))
+	assert.Equal(t, "10.00 EUR", out.Net.String())
+	assert.Equal(t, "11.90 EUR", out.Gross.String())
+}
+
+func FuzzMoney_Binary(f *testing.F) {
+	for _, s := range []string{"0", "12.34", "-0.01", "1e30", "-123456789012345678901234567890.123", "1e-50"} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		amount, err := decimal.NewFromString(s)
+		if err != nil {
+			t.Skip()
+		}
+		m := exactMoney(amount.String(), "USD")
+
+		data, err := m.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var r money.Money
+		if err := r.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if !r.Amount().Equal(m.Amount()) || r.Currency().Code != "USD" {
+			t.Fatalf("%s round trip gives %s", m, r)
+		}
+	})
+}
+
+func FuzzMoney_UnmarshalBinary(f *testing.F) {
+	data, _ := money.New(1234, "USD").MarshalBinary()
+	f.Add(data)
+	f.Add([]byte{1, 0, 0, 0x0b, 1, 2})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var m money.Money
+		if m.UnmarshalBinary(data) != nil {
+			return
+		}
+
+		again, err := m.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var r money.Money
+		if err := r.UnmarshalBinary(again); err != nil {
+			t.Fatal(err)
+		}
+		if !r.Amount().Equal(m.Amount()) {
+			t.Fatalf("%s round trip gives %s", m.Amount(), r.Amount())
+		}
+	})
+}
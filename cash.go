@@ -0,0 +1,102 @@
+package money
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// cashUnit returns the smallest cash amount of the currency
+func (c *Currency) cashUnit() decimal.Decimal {
+	increment := c.CashIncrement
+	if increment <= 0 {
+		increment = 1
+	}
+
+	return decimal.New(int64(increment), -int32(c.Fraction))
+}
+
+// RoundCash returns new Money struct with value rounded to the currency cash increment
+// using the rounding mode, e.g. CHF 1.23 becomes CHF 1.25 with HalfUp.
+// Zero mode stands for RoundingMode of Money
+func (m *Money) RoundCash(mode RoundingMode) (*Money, error) {
+	if mode == 0 {
+		mode = m.RoundingMode()
+	}
+
+	unit := m.currency.cashUnit()
+	units, err := divide(m.amount, unit, 0, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: units.Mul(unit), currency: m.currency, rounding: m.rounding}, nil
+}
+
+// SplitCash returns slice of Money structs with split Self value in given number like Split,
+// but every party receives a multiple of the currency cash increment.
+// Self value is rounded by RoundCash with the rounding mode first, parts add up to the rounded value
+func (m *Money) SplitCash(n int, mode RoundingMode) ([]*Money, error) {
+	if n <= 0 {
+		return nil, errors.New("split must be higher than zero")
+	}
+
+	ratios := make([]int, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+
+	return m.AllocateCash(mode, ratios...)
+}
+
+// AllocateCash returns slice of Money structs with split Self value in given ratios like Allocate,
+// but every party receives a multiple of the currency cash increment.
+// Self value is rounded by RoundCash with the rounding mode first, parts add up to the rounded value
+func (m *Money) AllocateCash(mode RoundingMode, ratios ...int) ([]*Money, error) {
+	if len(ratios) == 0 {
+		return nil, errors.New("no ratios specified")
+	}
+
+	var sum int64
+	for _, r := range ratios {
+		if r < 0 {
+			return nil, errors.New("ratios must not be negative")
+		}
+		sum += int64(r)
+	}
+	if sum == 0 {
+		return nil, errors.New("sum of ratios must be higher than zero")
+	}
+
+	cash, err := m.RoundCash(mode)
+	if err != nil {
+		return nil, err
+	}
+
+	unit := m.currency.cashUnit()
+	units, _ := cash.amount.QuoRem(unit, 0)
+
+	parts := make([]decimal.Decimal, len(ratios))
+	left := units
+	for i, r := range ratios {
+		parts[i], _ = units.Mul(decimal.New(int64(r), 0)).QuoRem(decimal.New(sum, 0), 0)
+		left = left.Sub(parts[i])
+	}
+
+	// leftover units are distributed amongst the first parties with non zero ratio
+	step := decimal.New(int64(left.Sign()), 0)
+	for i := 0; !left.IsZero(); i++ {
+		if ratios[i] == 0 {
+			continue
+		}
+		parts[i] = parts[i].Add(step)
+		left = left.Sub(step)
+	}
+
+	result := make([]*Money, len(parts))
+	for i, p := range parts {
+		result[i] = &Money{amount: p.Mul(unit), currency: m.currency, rounding: m.rounding}
+	}
+
+	return result, nil
+}
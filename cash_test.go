@@ -0,0 +1,88 @@
+package money_test
+
+import (
+	"testing"
+
+	"github.com/amanbolat/go-money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_RoundCash(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		mode     money.RoundingMode
+		expected string
+	}{
+		{123, "CHF", money.HalfUp, "1.25"},
+		{122, "CHF", money.HalfUp, "1.2"},
+		{122, "CHF", money.Up, "1.25"},
+		{-123, "CHF", money.HalfUp, "-1.25"},
+		{-123, "CHF", money.Ceiling, "-1.2"},
+		{1249, "SEK", money.HalfUp, "12"},
+		{1250, "SEK", money.HalfEven, "12"},
+		{1350, "SEK", money.HalfEven, "14"},
+		{1274, "DKK", money.HalfUp, "12.5"},
+		{1276, "DKK", money.HalfUp, "13"},
+		{1299, "CAD", money.Down, "12.95"},
+		{1299, "EUR", money.Down, "12.99"},
+		{1234, "CHF", 0, "12.35"},
+	}
+
+	for _, tc := range tcs {
+		r, err := money.New(tc.amount, tc.code).RoundCash(tc.mode)
+		if assert.NoError(t, err) {
+			assert.Equal(t, tc.expected, r.Amount().String(), "%d %s %s", tc.amount, tc.code, tc.mode)
+		}
+	}
+
+	_, err := money.New(123, "CHF").RoundCash(money.Unnecessary)
+	assert.Equal(t, money.ErrRoundingNecessary, err)
+}
+
+func TestMoney_SplitCash(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		split    int
+		expected []string
+	}{
+		{1000, "CHF", 3, []string{"3.35", "3.35", "3.3"}},
+		{1001, "CHF", 3, []string{"3.35", "3.35", "3.3"}},
+		{1003, "CHF", 2, []string{"5.05", "5"}},
+		{-1000, "CHF", 3, []string{"-3.35", "-3.35", "-3.3"}},
+		{1000, "SEK", 3, []string{"4", "3", "3"}},
+		{100, "EUR", 3, []string{"0.34", "0.33", "0.33"}},
+	}
+
+	for _, tc := range tcs {
+		parts, err := money.New(tc.amount, tc.code).SplitCash(tc.split, money.HalfUp)
+		if assert.NoError(t, err) {
+			var actual []string
+			for _, p := range parts {
+				actual = append(actual, p.Amount().String())
+			}
+			assert.Equal(t, tc.expected, actual)
+		}
+	}
+
+	_, err := money.New(100, "CHF").SplitCash(0, money.HalfUp)
+	assert.Error(t, err)
+}
+
+func TestMoney_AllocateCash(t *testing.T) {
+	parts, err := money.New(1000, "CHF").AllocateCash(money.HalfUp, 1, 0, 2)
+	if assert.NoError(t, err) {
+		var actual []string
+		for _, p := range parts {
+			actual = append(actual, p.Amount().String())
+		}
+		assert.Equal(t, []string{"3.35", "0", "6.65"}, actual)
+	}
+
+	_, err = money.New(1000, "CHF").AllocateCash(money.HalfUp)
+	assert.Error(t, err)
+
+	_, err = money.New(1000, "CHF").AllocateCash(money.HalfUp, 0, 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,87 @@
+// Package cldr provides number and currency formatting data derived from
+// the Unicode Common Locale Data Repository.
+//
+// Data is generated from cldr-json files by internal/gencldr
+// and compiled into the package, so no files are read at runtime.
+package cldr
+
+//go:generate go run ../internal/gencldr -data ../internal/gencldr/data -o data.go
+
+import (
+	"strings"
+)
+
+// Symbol represents currency symbols used by a locale
+type Symbol struct {
+	Standard string
+	Narrow   string
+}
+
+// Locale represents number and currency formatting data of a locale
+type Locale struct {
+	Tag             string
+	Decimal         string
+	Group           string
+	Minus           string
+	PrimaryGroup    int
+	SecondaryGroup  int
+	CurrencyPattern string
+	Symbols         map[string]Symbol
+}
+
+// Lookup returns locale data for the given tag, e.g. "de-DE" or "de_DE".
+// When the tag is not present, parent locales are tried by removing subtags,
+// so "de-LU" falls back to "de"
+func Lookup(tag string) (*Locale, bool) {
+	tag = Normalize(tag)
+	for tag != "" {
+		if l, ok := locales[tag]; ok {
+			return l, true
+		}
+
+		i := strings.LastIndexByte(tag, '-')
+		if i < 0 {
+			break
+		}
+		tag = tag[:i]
+	}
+
+	return nil, false
+}
+
+// Tags returns tags of all available locales
+func Tags() []string {
+	tags := make([]string, 0, len(locales))
+	for tag := range locales {
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+// Symbol returns standard and narrow symbols of the currency.
+// Currency code is used when locale has no symbol for the currency
+func (l *Locale) Symbol(code string) Symbol {
+	if s, ok := l.Symbols[code]; ok {
+		return s
+	}
+
+	return Symbol{Standard: code, Narrow: code}
+}
+
+// Normalize converts tags like "de_de" into "de-DE"
+func Normalize(tag string) string {
+	parts := strings.Split(strings.Replace(tag, "_", "-", -1), "-")
+	parts[0] = strings.ToLower(parts[0])
+	for i := 1; i < len(parts); i++ {
+		switch len(parts[i]) {
+		case 4:
+			// script subtag, e.g. Hant
+			parts[i] = strings.ToUpper(parts[i][:1]) + strings.ToLower(parts[i][1:])
+		default:
+			parts[i] = strings.ToUpper(parts[i])
+		}
+	}
+
+	return strings.Join(parts, "-")
+}
@@ -0,0 +1,59 @@
+package cldr_test
+
+import (
+	"testing"
+
+	"github.com/amanbolat/go-money/cldr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	tcs := []struct {
+		tag      string
+		expected string
+	}{
+		{"de-DE", "de-DE"},
+		{"de_de", "de-DE"},
+		{"de-LU", "de"},
+		{"en-IE", "en-IE"},
+		{"zh-TW", "zh-TW"},
+	}
+
+	for _, tc := range tcs {
+		l, ok := cldr.Lookup(tc.tag)
+
+		if assert.Truef(t, ok, "Expected locale %s to be found", tc.tag) {
+			assert.Equal(t, tc.expected, l.Tag)
+		}
+	}
+
+	_, ok := cldr.Lookup("xx-YY")
+	assert.False(t, ok)
+}
+
+func TestLocale_Data(t *testing.T) {
+	l, _ := cldr.Lookup("en-IN")
+	assert.Equal(t, 3, l.PrimaryGroup)
+	assert.Equal(t, 2, l.SecondaryGroup)
+
+	l, _ = cldr.Lookup("de-DE")
+	assert.Equal(t, ",", l.Decimal)
+	assert.Equal(t, ".", l.Group)
+	assert.Equal(t, "#,##0.00\u00a0¤", l.CurrencyPattern)
+}
+
+func TestLocale_Symbol(t *testing.T) {
+	l, _ := cldr.Lookup("en-CA")
+	assert.Equal(t, cldr.Symbol{Standard: "$", Narrow: "$"}, l.Symbol("CAD"))
+	assert.Equal(t, cldr.Symbol{Standard: "US$", Narrow: "$"}, l.Symbol("USD"))
+	assert.Equal(t, cldr.Symbol{Standard: "XYZ", Narrow: "XYZ"}, l.Symbol("XYZ"))
+}
+
+func TestTags(t *testing.T) {
+	assert.True(t, len(cldr.Tags()) >= 30)
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "en-US", cldr.Normalize("EN_us"))
+	assert.Equal(t, "zh-Hant-HK", cldr.Normalize("zh_hant_hk"))
+}
@@ -0,0 +1,1942 @@
+// Code generated by gencldr from cldr-json; DO NOT EDIT.
+
+package cldr
+
+var locales = map[string]*Locale{
+	"ar-AE": {
+		Tag: "ar-AE", Decimal: ".", Group: ",", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u200f#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AED": {Standard: "\u062f.\u0625.\u200f", Narrow: "\u062f.\u0625.\u200f"},
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JP\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"cs-CZ": {
+		Tag: "cs-CZ", Decimal: ",", Group: "\u00a0", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "K\u010d", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JP\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"da-DK": {
+		Tag: "da-DK", Decimal: ",", Group: ".", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "kr.", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JP\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"de": {
+		Tag: "de", Decimal: ",", Group: ".", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "AU$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"de-AT": {
+		Tag: "de-AT", Decimal: ",", Group: "\u00a0", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4\u00a0#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "AU$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"de-CH": {
+		Tag: "de-CH", Decimal: ".", Group: "\u2019", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4\u00a0#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "AU$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "EUR", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"de-DE": {
+		Tag: "de-DE", Decimal: ",", Group: ".", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "AU$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"el-GR": {
+		Tag: "el-GR", Decimal: ",", Group: ".", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JP\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"en": {
+		Tag: "en", Decimal: ".", Group: ",", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"en-AU": {
+		Tag: "en-AU", Decimal: ".", Group: ",", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "USD", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"en-CA": {
+		Tag: "en-CA", Decimal: ".", Group: ",", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"en-GB": {
+		Tag: "en-GB", Decimal: ".", Group: ",", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"en-IE": {
+		Tag: "en-IE", Decimal: ".", Group: ",", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"en-IN": {
+		Tag: "en-IN", Decimal: ".", Group: ",", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 2, CurrencyPattern: "\u00a4#,##,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"en-NZ": {
+		Tag: "en-NZ", Decimal: ".", Group: ",", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"en-SG": {
+		Tag: "en-SG", Decimal: ".", Group: ",", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "$", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"en-US": {
+		Tag: "en-US", Decimal: ".", Group: ",", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"en-ZA": {
+		Tag: "en-ZA", Decimal: ",", Group: "\u00a0", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "R", Narrow: "R"},
+		},
+	},
+	"es": {
+		Tag: "es", Decimal: ",", Group: ".", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CNY", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JPY", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"es-ES": {
+		Tag: "es-ES", Decimal: ",", Group: ".", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CNY", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JPY", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"es-MX": {
+		Tag: "es-MX", Decimal: ".", Group: ",", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CNY", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "EUR", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JPY", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "USD", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"fi-FI": {
+		Tag: "fi-FI", Decimal: ",", Group: "\u00a0", Minus: "\u2212",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"fr": {
+		Tag: "fr", Decimal: ",", Group: "\u202f", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "$AU", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "$CA", Narrow: "$"},
+			"CNY": {Standard: "CNY", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3GB", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JPY", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "$MX", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "$NZ", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "TWD", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "$US", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"fr-BE": {
+		Tag: "fr-BE", Decimal: ",", Group: "\u202f", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "$AU", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "$CA", Narrow: "$"},
+			"CNY": {Standard: "CNY", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3GB", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JPY", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "$MX", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "$NZ", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "TWD", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "$US", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"fr-CA": {
+		Tag: "fr-CA", Decimal: ",", Group: "\u00a0", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "$\u00a0AU", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "$", Narrow: "$"},
+			"CNY": {Standard: "CNY", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JPY", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "$MX", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "$NZ", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "TWD", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "$\u00a0US", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"fr-CH": {
+		Tag: "fr-CH", Decimal: ",", Group: "\u202f", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "$AU", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "$CA", Narrow: "$"},
+			"CNY": {Standard: "CNY", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3GB", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JPY", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "$MX", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "$NZ", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "TWD", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "$US", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"fr-FR": {
+		Tag: "fr-FR", Decimal: ",", Group: "\u202f", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "$AU", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "$CA", Narrow: "$"},
+			"CNY": {Standard: "CNY", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3GB", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JPY", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "$MX", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "$NZ", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "TWD", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "$US", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"hu-HU": {
+		Tag: "hu-HU", Decimal: ",", Group: "\u00a0", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "Ft", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "USD", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"it-IT": {
+		Tag: "it-IT", Decimal: ",", Group: ".", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JPY", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "USD", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"ja-JP": {
+		Tag: "ja-JP", Decimal: ".", Group: ",", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "\u5143", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "\uffe5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"ko-KR": {
+		Tag: "ko-KR", Decimal: ".", Group: ",", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JP\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"nb-NO": {
+		Tag: "nb-NO", Decimal: ",", Group: "\u00a0", Minus: "\u2212",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JP\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "kr", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "USD", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"nl-BE": {
+		Tag: "nl-BE", Decimal: ",", Group: ".", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4\u00a0#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "AU$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "C$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JP\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"nl-NL": {
+		Tag: "nl-NL", Decimal: ",", Group: ".", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4\u00a0#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "AU$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "C$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JP\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"pl-PL": {
+		Tag: "pl-PL", Decimal: ",", Group: "\u00a0", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JPY", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "z\u0142", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "USD", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"pt-BR": {
+		Tag: "pt-BR", Decimal: ",", Group: ".", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4\u00a0#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JP\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"pt-PT": {
+		Tag: "pt-PT", Decimal: ",", Group: "\u00a0", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JP\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"ro-RO": {
+		Tag: "ro-RO", Decimal: ",", Group: ".", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JP\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "USD", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"ru-RU": {
+		Tag: "ru-RU", Decimal: ",", Group: "\u00a0", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "\u20bd", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "\u20b4", Narrow: "\u20b4"},
+			"USD": {Standard: "$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"sv-SE": {
+		Tag: "sv-SE", Decimal: ",", Group: "\u00a0", Minus: "\u2212",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "Dkr", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JP\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "Nkr", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "kr", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"tr-TR": {
+		Tag: "tr-TR", Decimal: ",", Group: ".", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "\u20ba", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"uk-UA": {
+		Tag: "uk-UA", Decimal: ",", Group: "\u00a0", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "#,##0.00\u00a0\u00a4",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JP\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "\u20b4", Narrow: "\u20b4"},
+			"USD": {Standard: "USD", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"zh-CN": {
+		Tag: "zh-CN", Decimal: ".", Group: ",", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JP\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"zh-HK": {
+		Tag: "zh-HK", Decimal: ".", Group: ",", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JP\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "NT$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+	"zh-TW": {
+		Tag: "zh-TW", Decimal: ".", Group: ",", Minus: "-",
+		PrimaryGroup: 3, SecondaryGroup: 0, CurrencyPattern: "\u00a4#,##0.00",
+		Symbols: map[string]Symbol{
+			"AUD": {Standard: "A$", Narrow: "$"},
+			"BRL": {Standard: "R$", Narrow: "R$"},
+			"CAD": {Standard: "CA$", Narrow: "$"},
+			"CNY": {Standard: "CN\u00a5", Narrow: "\u00a5"},
+			"CZK": {Standard: "CZK", Narrow: "K\u010d"},
+			"DKK": {Standard: "DKK", Narrow: "kr"},
+			"EUR": {Standard: "\u20ac", Narrow: "\u20ac"},
+			"GBP": {Standard: "\u00a3", Narrow: "\u00a3"},
+			"GEL": {Standard: "GEL", Narrow: "\u20be"},
+			"HKD": {Standard: "HK$", Narrow: "$"},
+			"HUF": {Standard: "HUF", Narrow: "Ft"},
+			"ILS": {Standard: "\u20aa", Narrow: "\u20aa"},
+			"INR": {Standard: "\u20b9", Narrow: "\u20b9"},
+			"JPY": {Standard: "JP\u00a5", Narrow: "\u00a5"},
+			"KRW": {Standard: "\u20a9", Narrow: "\u20a9"},
+			"KZT": {Standard: "KZT", Narrow: "\u20b8"},
+			"MXN": {Standard: "MX$", Narrow: "$"},
+			"NGN": {Standard: "NGN", Narrow: "\u20a6"},
+			"NOK": {Standard: "NOK", Narrow: "kr"},
+			"NZD": {Standard: "NZ$", Narrow: "$"},
+			"PHP": {Standard: "\u20b1", Narrow: "\u20b1"},
+			"PLN": {Standard: "PLN", Narrow: "z\u0142"},
+			"RON": {Standard: "RON", Narrow: "lei"},
+			"RUB": {Standard: "RUB", Narrow: "\u20bd"},
+			"SEK": {Standard: "SEK", Narrow: "kr"},
+			"SGD": {Standard: "SGD", Narrow: "$"},
+			"THB": {Standard: "THB", Narrow: "\u0e3f"},
+			"TRY": {Standard: "TRY", Narrow: "\u20ba"},
+			"TWD": {Standard: "$", Narrow: "$"},
+			"UAH": {Standard: "UAH", Narrow: "\u20b4"},
+			"USD": {Standard: "US$", Narrow: "$"},
+			"VND": {Standard: "\u20ab", Narrow: "\u20ab"},
+			"XAF": {Standard: "FCFA", Narrow: "FCFA"},
+			"XCD": {Standard: "EC$", Narrow: "$"},
+			"XOF": {Standard: "F\u202fCFA", Narrow: "F\u202fCFA"},
+			"XPF": {Standard: "CFPF", Narrow: "CFPF"},
+			"ZAR": {Standard: "ZAR", Narrow: "R"},
+		},
+	},
+}
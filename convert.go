@@ -0,0 +1,105 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrRateNotFound is returned by rate providers which have no rate for the currency pair
+var ErrRateNotFound = errors.New("money: rate not found")
+
+// RateProvider returns exchange rate between currencies at the given time.
+// Rate is the amount of to currency for one unit of from currency,
+// e.g. 1.1 for EUR to USD means 1 EUR = 1.1 USD
+type RateProvider interface {
+	Rate(from, to *Currency, at time.Time) (decimal.Decimal, error)
+}
+
+// RateProviderFunc lets use ordinary functions as RateProvider
+type RateProviderFunc func(from, to *Currency, at time.Time) (decimal.Decimal, error)
+
+// Rate calls f(from, to, at)
+func (f RateProviderFunc) Rate(from, to *Currency, at time.Time) (decimal.Decimal, error) {
+	return f(from, to, at)
+}
+
+// Converter converts Money between currencies using rates of RateProvider.
+// Converter is safe for concurrent use if its provider is
+type Converter struct {
+	provider RateProvider
+	rounding RoundingMode
+}
+
+// NewConverter creates Converter using rates of the provider. Converted amount is rounded
+// by target currency Fraction using the rounding mode, zero mode stands for RoundingMode
+// of converted Money
+func NewConverter(provider RateProvider, mode RoundingMode) *Converter {
+	return &Converter{provider: provider, rounding: mode}
+}
+
+// Rounding returns rounding mode of the converter
+func (c *Converter) Rounding() RoundingMode {
+	return c.rounding
+}
+
+// Convert returns Money converted to the currency using rate at the given time along with the rate.
+// Money of the same currency is returned with rate 1 without asking the provider.
+// Error is returned if the converter or its provider is nil
+func (c *Converter) Convert(m *Money, to *Currency, at time.Time) (*Money, decimal.Decimal, error) {
+	if c == nil || c.provider == nil {
+		return nil, decimal.Zero, errors.New("money: nil converter")
+	}
+	if m == nil || m.currency == nil || to == nil {
+		return nil, decimal.Zero, errors.New("money: cannot convert Money without currency")
+	}
+
+	rate := decimal.New(1, 0)
+	if !m.currency.equals(to) {
+		var err error
+		if rate, err = c.provider.Rate(m.currency, to, at); err != nil {
+			return nil, decimal.Zero, err
+		}
+		if rate.Sign() <= 0 {
+			return nil, decimal.Zero, fmt.Errorf("money: invalid rate %s for %s/%s", rate, m.currency.Code, to.Code)
+		}
+	}
+
+	converted, err := m.exchange(to, rate, c.rounding)
+	if err != nil {
+		return nil, decimal.Zero, err
+	}
+
+	return converted, rate, nil
+}
+
+// exchange returns Money multiplied by rate in the currency rounded by its Fraction
+// using the rounding mode, zero mode stands for RoundingMode of the result
+func (m *Money) exchange(to *Currency, rate decimal.Decimal, mode RoundingMode) (*Money, error) {
+	converted := &Money{amount: m.amount.Mul(rate), currency: to, rounding: m.rounding}
+	if mode == 0 {
+		mode = converted.RoundingMode()
+	}
+
+	amount, err := round(converted.amount, int32(to.Fraction), mode)
+	if err != nil {
+		return nil, err
+	}
+	converted.amount = amount
+
+	return converted, nil
+}
+
+// Convert returns Money converted to the currency using current rate of the converter
+// along with the rate
+func (m *Money) Convert(to *Currency, c *Converter) (*Money, decimal.Decimal, error) {
+	return c.Convert(m, to, time.Now())
+}
+
+// ConvertAt returns Money converted to the currency using rate of the converter
+// at the given time along with the rate
+func (m *Money) ConvertAt(to *Currency, c *Converter, at time.Time) (*Money, decimal.Decimal, error) {
+	return c.Convert(m, to, at)
+}
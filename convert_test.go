@@ -0,0 +1,98 @@
+package money_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/amanbolat/go-money"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fixedRates map[string]string
+
+func (r fixedRates) Rate(from, to *money.Currency, _ time.Time) (decimal.Decimal, error) {
+	rate, ok := r[from.Code+"/"+to.Code]
+	if !ok {
+		return decimal.Zero, fmt.Errorf("%w: %s/%s", money.ErrRateNotFound, from.Code, to.Code)
+	}
+
+	return decimal.RequireFromString(rate), nil
+}
+
+func TestMoney_Convert(t *testing.T) {
+	rates := fixedRates{"EUR/USD": "1.0845", "USD/JPY": "151.37", "EUR/XXX": "0", "JPY/USD": "0.0066063"}
+
+	tcs := []struct {
+		money    *money.Money
+		to       string
+		mode     money.RoundingMode
+		expected string
+		rate     string
+	}{
+		{money.New(1000, "EUR"), "USD", 0, "10.85 USD", "1.0845"},
+		{money.New(1000, "EUR"), "USD", money.Down, "10.84 USD", "1.0845"},
+		{money.New(-1000, "EUR"), "USD", money.Floor, "-10.85 USD", "1.0845"},
+		{money.New(1234, "USD"), "JPY", 0, "1868 JPY", "151.37"},
+		{money.New(1868, "JPY"), "USD", money.HalfEven, "12.34 USD", "0.0066063"},
+		{money.New(1234, "USD"), "USD", 0, "12.34 USD", "1"},
+	}
+
+	for _, tc := range tcs {
+		c := money.NewConverter(rates, tc.mode)
+		r, rate, err := tc.money.Convert(money.GetCurrency(tc.to), c)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, r.String())
+		assert.Equal(t, tc.rate, rate.String())
+	}
+}
+
+func TestMoney_ConvertErrors(t *testing.T) {
+	rates := fixedRates{"EUR/USD": "1.0845", "EUR/GBP": "0"}
+	m := money.New(1000, "EUR")
+
+	_, _, err := m.Convert(money.GetCurrency("JPY"), money.NewConverter(rates, 0))
+	assert.True(t, errors.Is(err, money.ErrRateNotFound))
+
+	_, _, err = m.Convert(money.GetCurrency("GBP"), money.NewConverter(rates, 0))
+	assert.Error(t, err)
+
+	_, _, err = m.Convert(money.GetCurrency("USD"), money.NewConverter(rates, money.Unnecessary))
+	assert.True(t, errors.Is(err, money.ErrRoundingNecessary))
+
+	_, _, err = m.Convert(nil, money.NewConverter(rates, 0))
+	assert.Error(t, err)
+
+	assert.NotPanics(t, func() {
+		_, _, err = m.Convert(money.GetCurrency("USD"), nil)
+		assert.EqualError(t, err, "money: nil converter")
+
+		_, _, err = m.ConvertAt(money.GetCurrency("USD"), nil, time.Now())
+		assert.EqualError(t, err, "money: nil converter")
+
+		_, _, err = m.Convert(money.GetCurrency("USD"), money.NewConverter(nil, 0))
+		assert.EqualError(t, err, "money: nil converter")
+	})
+}
+
+func TestMoney_ConvertAt(t *testing.T) {
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	provider := money.RateProviderFunc(func(from, to *money.Currency, at time.Time) (decimal.Decimal, error) {
+		if at.Before(day) {
+			return decimal.RequireFromString("1.1"), nil
+		}
+		return decimal.RequireFromString("1.2"), nil
+	})
+	c := money.NewConverter(provider, 0)
+
+	r, _, err := money.New(100, "EUR").ConvertAt(money.GetCurrency("USD"), c, day.Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, "1.10 USD", r.String())
+
+	r, _, err = money.New(100, "EUR").ConvertAt(money.GetCurrency("USD"), c, day)
+	require.NoError(t, err)
+	assert.Equal(t, "1.20 USD", r.String())
+}
@@ -0,0 +1,206 @@
+// Code generated by gencurrency from ISO 4217 lists; DO NOT EDIT.
+
+package money
+
+import "github.com/shopspring/decimal"
+
+// currencies represents a collection of built-in currencies,
+// it is copied into every Registry and must not be modified
+var currencies = map[string]*Currency{
+	"AED": {Decimal: ".", Thousand: ",", Code: "AED", Fraction: 2, Grapheme: ".\u062f.\u0625", Template: "1 $", Name: "UAE Dirham", NumericCode: "784"},
+	"AFN": {Decimal: ".", Thousand: ",", Code: "AFN", Fraction: 2, Grapheme: "\u060b", Template: "1 $", Name: "Afghani", NumericCode: "971"},
+	"ALL": {Decimal: ".", Thousand: ",", Code: "ALL", Fraction: 2, Grapheme: "L", Template: "$1", Name: "Lek", NumericCode: "008"},
+	"AMD": {Decimal: ".", Thousand: ",", Code: "AMD", Fraction: 2, Grapheme: "\u0564\u0580.", Template: "1 $", Name: "Armenian Dram", NumericCode: "051"},
+	"ANG": {Decimal: ".", Thousand: ",", Code: "ANG", Fraction: 2, Grapheme: "\u0192", Template: "$1", Name: "Netherlands Antillean Guilder", NumericCode: "532"},
+	"AOA": {Decimal: ".", Thousand: ",", Code: "AOA", Fraction: 2, Grapheme: "Kz", Template: "$1", Name: "Kwanza", NumericCode: "973"},
+	"ARS": {Decimal: ".", Thousand: ",", Code: "ARS", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Argentine Peso", NumericCode: "032"},
+	"AUD": {Decimal: ".", Thousand: ",", Code: "AUD", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Australian Dollar", NumericCode: "036", CashIncrement: 5},
+	"AWG": {Decimal: ".", Thousand: ",", Code: "AWG", Fraction: 2, Grapheme: "\u0192", Template: "$1", Name: "Aruban Florin", NumericCode: "533"},
+	"AZN": {Decimal: ".", Thousand: ",", Code: "AZN", Fraction: 2, Grapheme: "\u20bc", Template: "$1", Name: "Azerbaijan Manat", NumericCode: "944"},
+	"BAM": {Decimal: ".", Thousand: ",", Code: "BAM", Fraction: 2, Grapheme: "KM", Template: "$1", Name: "Convertible Mark", NumericCode: "977"},
+	"BBD": {Decimal: ".", Thousand: ",", Code: "BBD", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Barbados Dollar", NumericCode: "052"},
+	"BDT": {Decimal: ".", Thousand: ",", Code: "BDT", Fraction: 2, Grapheme: "\u09f3", Template: "$1", Name: "Taka", NumericCode: "050"},
+	"BGN": {Decimal: ".", Thousand: ",", Code: "BGN", Fraction: 2, Grapheme: "\u043b\u0432", Template: "$1", Name: "Bulgarian Lev", NumericCode: "975"},
+	"BHD": {Decimal: ".", Thousand: ",", Code: "BHD", Fraction: 3, Grapheme: ".\u062f.\u0628", Template: "1 $", Name: "Bahraini Dinar", NumericCode: "048"},
+	"BIF": {Decimal: ".", Thousand: ",", Code: "BIF", Fraction: 0, Grapheme: "FBu", Template: "1 $", Name: "Burundi Franc", NumericCode: "108"},
+	"BMD": {Decimal: ".", Thousand: ",", Code: "BMD", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Bermudian Dollar", NumericCode: "060"},
+	"BND": {Decimal: ".", Thousand: ",", Code: "BND", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Brunei Dollar", NumericCode: "096"},
+	"BOB": {Decimal: ".", Thousand: ",", Code: "BOB", Fraction: 2, Grapheme: "Bs.", Template: "$1", Name: "Boliviano", NumericCode: "068"},
+	"BOV": {Decimal: ".", Thousand: ",", Code: "BOV", Fraction: 2, Grapheme: "BOV", Template: "1 $", Name: "Mvdol", NumericCode: "984"},
+	"BRL": {Decimal: ".", Thousand: ",", Code: "BRL", Fraction: 2, Grapheme: "R$", Template: "$1", Name: "Brazilian Real", NumericCode: "986"},
+	"BSD": {Decimal: ".", Thousand: ",", Code: "BSD", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Bahamian Dollar", NumericCode: "044"},
+	"BTN": {Decimal: ".", Thousand: ",", Code: "BTN", Fraction: 2, Grapheme: "Nu.", Template: "$1", Name: "Ngultrum", NumericCode: "064"},
+	"BWP": {Decimal: ".", Thousand: ",", Code: "BWP", Fraction: 2, Grapheme: "P", Template: "$1", Name: "Pula", NumericCode: "072"},
+	"BYN": {Decimal: ".", Thousand: ",", Code: "BYN", Fraction: 2, Grapheme: "p.", Template: "1 $", Name: "Belarusian Ruble", NumericCode: "933", ValidFrom: date(2016, 7, 1)},
+	"BYR": {Decimal: ".", Thousand: ",", Code: "BYR", Fraction: 0, Grapheme: "p.", Template: "1 $", Name: "Belarusian Ruble", NumericCode: "974", Historic: true, ValidUntil: date(2017, 2, 1), Successor: &Redenomination{Code: "BYN", Rate: decimal.RequireFromString("10000")}},
+	"BZD": {Decimal: ".", Thousand: ",", Code: "BZD", Fraction: 2, Grapheme: "BZ$", Template: "$1", Name: "Belize Dollar", NumericCode: "084"},
+	"CAD": {Decimal: ".", Thousand: ",", Code: "CAD", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Canadian Dollar", NumericCode: "124", CashIncrement: 5},
+	"CDF": {Decimal: ".", Thousand: ",", Code: "CDF", Fraction: 2, Grapheme: "FC", Template: "1 $", Name: "Congolese Franc", NumericCode: "976"},
+	"CHE": {Decimal: ".", Thousand: ",", Code: "CHE", Fraction: 2, Grapheme: "CHE", Template: "1 $", Name: "WIR Euro", NumericCode: "947"},
+	"CHF": {Decimal: ".", Thousand: ",", Code: "CHF", Fraction: 2, Grapheme: "CHF", Template: "$ 1", Name: "Swiss Franc", NumericCode: "756", CashIncrement: 5},
+	"CHW": {Decimal: ".", Thousand: ",", Code: "CHW", Fraction: 2, Grapheme: "CHW", Template: "1 $", Name: "WIR Franc", NumericCode: "948"},
+	"CLF": {Decimal: ".", Thousand: ",", Code: "CLF", Fraction: 4, Grapheme: "UF", Template: "$ 1", Name: "Unidad de Fomento", NumericCode: "990"},
+	"CLP": {Decimal: ".", Thousand: ",", Code: "CLP", Fraction: 0, Grapheme: "$", Template: "$1", Name: "Chilean Peso", NumericCode: "152"},
+	"CNY": {Decimal: ".", Thousand: ",", Code: "CNY", Fraction: 2, Grapheme: "\u5143", Template: "1 $", Name: "Yuan Renminbi", NumericCode: "156"},
+	"COP": {Decimal: ".", Thousand: ",", Code: "COP", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Colombian Peso", NumericCode: "170"},
+	"COU": {Decimal: ".", Thousand: ",", Code: "COU", Fraction: 2, Grapheme: "COU", Template: "1 $", Name: "Unidad de Valor Real", NumericCode: "970"},
+	"CRC": {Decimal: ".", Thousand: ",", Code: "CRC", Fraction: 2, Grapheme: "\u20a1", Template: "$1", Name: "Costa Rican Colon", NumericCode: "188"},
+	"CUC": {Decimal: ".", Thousand: ",", Code: "CUC", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Peso Convertible", NumericCode: "931"},
+	"CUP": {Decimal: ".", Thousand: ",", Code: "CUP", Fraction: 2, Grapheme: "$MN", Template: "$1", Name: "Cuban Peso", NumericCode: "192"},
+	"CVE": {Decimal: ".", Thousand: ",", Code: "CVE", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Cabo Verde Escudo", NumericCode: "132"},
+	"CZK": {Decimal: ".", Thousand: ",", Code: "CZK", Fraction: 2, Grapheme: "K\u010d", Template: "1 $", Name: "Czech Koruna", NumericCode: "203", CashIncrement: 100},
+	"DJF": {Decimal: ".", Thousand: ",", Code: "DJF", Fraction: 0, Grapheme: "Fdj", Template: "1 $", Name: "Djibouti Franc", NumericCode: "262"},
+	"DKK": {Decimal: ".", Thousand: ",", Code: "DKK", Fraction: 2, Grapheme: "kr", Template: "1 $", Name: "Danish Krone", NumericCode: "208", CashIncrement: 50},
+	"DOP": {Decimal: ".", Thousand: ",", Code: "DOP", Fraction: 2, Grapheme: "RD$", Template: "$1", Name: "Dominican Peso", NumericCode: "214"},
+	"DZD": {Decimal: ".", Thousand: ",", Code: "DZD", Fraction: 2, Grapheme: ".\u062f.\u062c", Template: "1 $", Name: "Algerian Dinar", NumericCode: "012"},
+	"EEK": {Decimal: ".", Thousand: ",", Code: "EEK", Fraction: 2, Grapheme: "kr", Template: "$1", Name: "Kroon", NumericCode: "233", Historic: true, ValidUntil: date(2011, 2, 1), Successor: &Redenomination{Code: "EUR", Rate: decimal.RequireFromString("15.6466")}},
+	"EGP": {Decimal: ".", Thousand: ",", Code: "EGP", Fraction: 2, Grapheme: "\u00a3", Template: "$1", Name: "Egyptian Pound", NumericCode: "818"},
+	"ERN": {Decimal: ".", Thousand: ",", Code: "ERN", Fraction: 2, Grapheme: "Nfk", Template: "$1", Name: "Nakfa", NumericCode: "232"},
+	"ETB": {Decimal: ".", Thousand: ",", Code: "ETB", Fraction: 2, Grapheme: "Br", Template: "$1", Name: "Ethiopian Birr", NumericCode: "230"},
+	"EUR": {Decimal: ".", Thousand: ",", Code: "EUR", Fraction: 2, Grapheme: "\u20ac", Template: "$1", Name: "Euro", NumericCode: "978", ValidFrom: date(1999, 1, 1)},
+	"FJD": {Decimal: ".", Thousand: ",", Code: "FJD", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Fiji Dollar", NumericCode: "242"},
+	"FKP": {Decimal: ".", Thousand: ",", Code: "FKP", Fraction: 2, Grapheme: "\u00a3", Template: "$1", Name: "Falkland Islands Pound", NumericCode: "238"},
+	"GBP": {Decimal: ".", Thousand: ",", Code: "GBP", Fraction: 2, Grapheme: "\u00a3", Template: "$1", Name: "Pound Sterling", NumericCode: "826"},
+	"GEL": {Decimal: ".", Thousand: ",", Code: "GEL", Fraction: 2, Grapheme: "\u20be", Template: "1 $", Name: "Lari", NumericCode: "981"},
+	"GGP": {Decimal: ".", Thousand: ",", Code: "GGP", Fraction: 2, Grapheme: "\u00a3", Template: "$1", Name: "Guernsey Pound"},
+	"GHC": {Decimal: ".", Thousand: ",", Code: "GHC", Fraction: 2, Grapheme: "\u00a2", Template: "$1", Name: "Cedi", NumericCode: "288", Historic: true, ValidUntil: date(2008, 2, 1), Successor: &Redenomination{Code: "GHS", Rate: decimal.RequireFromString("10000")}},
+	"GHS": {Decimal: ".", Thousand: ",", Code: "GHS", Fraction: 2, Grapheme: "\u20b5", Template: "$1", Name: "Ghana Cedi", NumericCode: "936", ValidFrom: date(2007, 7, 1)},
+	"GIP": {Decimal: ".", Thousand: ",", Code: "GIP", Fraction: 2, Grapheme: "\u00a3", Template: "$1", Name: "Gibraltar Pound", NumericCode: "292"},
+	"GMD": {Decimal: ".", Thousand: ",", Code: "GMD", Fraction: 2, Grapheme: "D", Template: "$1", Name: "Dalasi", NumericCode: "270"},
+	"GNF": {Decimal: ".", Thousand: ",", Code: "GNF", Fraction: 0, Grapheme: "FG", Template: "1 $", Name: "Guinean Franc", NumericCode: "324"},
+	"GTQ": {Decimal: ".", Thousand: ",", Code: "GTQ", Fraction: 2, Grapheme: "Q", Template: "$1", Name: "Quetzal", NumericCode: "320"},
+	"GYD": {Decimal: ".", Thousand: ",", Code: "GYD", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Guyana Dollar", NumericCode: "328"},
+	"HKD": {Decimal: ".", Thousand: ",", Code: "HKD", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Hong Kong Dollar", NumericCode: "344"},
+	"HNL": {Decimal: ".", Thousand: ",", Code: "HNL", Fraction: 2, Grapheme: "L", Template: "$1", Name: "Lempira", NumericCode: "340"},
+	"HRK": {Decimal: ".", Thousand: ",", Code: "HRK", Fraction: 2, Grapheme: "kn", Template: "$1", Name: "Kuna", NumericCode: "191", Historic: true, ValidUntil: date(2023, 2, 1), Successor: &Redenomination{Code: "EUR", Rate: decimal.RequireFromString("7.5345")}},
+	"HTG": {Decimal: ".", Thousand: ",", Code: "HTG", Fraction: 2, Grapheme: "G", Template: "$1", Name: "Gourde", NumericCode: "332"},
+	"HUF": {Decimal: ".", Thousand: ",", Code: "HUF", Fraction: 2, Grapheme: "Ft", Template: "$1", Name: "Forint", NumericCode: "348", CashIncrement: 500},
+	"IDR": {Decimal: ".", Thousand: ",", Code: "IDR", Fraction: 2, Grapheme: "Rp", Template: "$1", Name: "Rupiah", NumericCode: "360"},
+	"ILS": {Decimal: ".", Thousand: ",", Code: "ILS", Fraction: 2, Grapheme: "\u20aa", Template: "$1", Name: "New Israeli Sheqel", NumericCode: "376"},
+	"IMP": {Decimal: ".", Thousand: ",", Code: "IMP", Fraction: 2, Grapheme: "\u00a3", Template: "$1", Name: "Manx Pound"},
+	"INR": {Decimal: ".", Thousand: ",", Code: "INR", Fraction: 2, Grapheme: "\u20b9", Template: "$1", Name: "Indian Rupee", NumericCode: "356"},
+	"IQD": {Decimal: ".", Thousand: ",", Code: "IQD", Fraction: 3, Grapheme: ".\u062f.\u0639", Template: "1 $", Name: "Iraqi Dinar", NumericCode: "368"},
+	"IRR": {Decimal: ".", Thousand: ",", Code: "IRR", Fraction: 2, Grapheme: "\ufdfc", Template: "1 $", Name: "Iranian Rial", NumericCode: "364"},
+	"ISK": {Decimal: ".", Thousand: ",", Code: "ISK", Fraction: 0, Grapheme: "kr", Template: "$1", Name: "Iceland Krona", NumericCode: "352"},
+	"JEP": {Decimal: ".", Thousand: ",", Code: "JEP", Fraction: 2, Grapheme: "\u00a3", Template: "$1", Name: "Jersey Pound"},
+	"JMD": {Decimal: ".", Thousand: ",", Code: "JMD", Fraction: 2, Grapheme: "J$", Template: "$1", Name: "Jamaican Dollar", NumericCode: "388"},
+	"JOD": {Decimal: ".", Thousand: ",", Code: "JOD", Fraction: 3, Grapheme: ".\u062f.\u0625", Template: "1 $", Name: "Jordanian Dinar", NumericCode: "400"},
+	"JPY": {Decimal: ".", Thousand: ",", Code: "JPY", Fraction: 0, Grapheme: "\u00a5", Template: "$1", Name: "Yen", NumericCode: "392"},
+	"KES": {Decimal: ".", Thousand: ",", Code: "KES", Fraction: 2, Grapheme: "KSh", Template: "$1", Name: "Kenyan Shilling", NumericCode: "404"},
+	"KGS": {Decimal: ".", Thousand: ",", Code: "KGS", Fraction: 2, Grapheme: "\u0441\u043e\u043c", Template: "$1", Name: "Som", NumericCode: "417"},
+	"KHR": {Decimal: ".", Thousand: ",", Code: "KHR", Fraction: 2, Grapheme: "\u17db", Template: "$1", Name: "Riel", NumericCode: "116"},
+	"KMF": {Decimal: ".", Thousand: ",", Code: "KMF", Fraction: 0, Grapheme: "CF", Template: "1 $", Name: "Comorian Franc", NumericCode: "174"},
+	"KPW": {Decimal: ".", Thousand: ",", Code: "KPW", Fraction: 2, Grapheme: "\u20a9", Template: "$1", Name: "North Korean Won", NumericCode: "408"},
+	"KRW": {Decimal: ".", Thousand: ",", Code: "KRW", Fraction: 0, Grapheme: "\u20a9", Template: "$1", Name: "Won", NumericCode: "410"},
+	"KWD": {Decimal: ".", Thousand: ",", Code: "KWD", Fraction: 3, Grapheme: ".\u062f.\u0643", Template: "1 $", Name: "Kuwaiti Dinar", NumericCode: "414"},
+	"KYD": {Decimal: ".", Thousand: ",", Code: "KYD", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Cayman Islands Dollar", NumericCode: "136"},
+	"KZT": {Decimal: ".", Thousand: ",", Code: "KZT", Fraction: 2, Grapheme: "\u20b8", Template: "$1", Name: "Tenge", NumericCode: "398"},
+	"LAK": {Decimal: ".", Thousand: ",", Code: "LAK", Fraction: 2, Grapheme: "\u20ad", Template: "$1", Name: "Lao Kip", NumericCode: "418"},
+	"LBP": {Decimal: ".", Thousand: ",", Code: "LBP", Fraction: 2, Grapheme: "\u00a3", Template: "$1", Name: "Lebanese Pound", NumericCode: "422"},
+	"LKR": {Decimal: ".", Thousand: ",", Code: "LKR", Fraction: 2, Grapheme: "\u20a8", Template: "$1", Name: "Sri Lanka Rupee", NumericCode: "144"},
+	"LRD": {Decimal: ".", Thousand: ",", Code: "LRD", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Liberian Dollar", NumericCode: "430"},
+	"LSL": {Decimal: ".", Thousand: ",", Code: "LSL", Fraction: 2, Grapheme: "L", Template: "$1", Name: "Loti", NumericCode: "426"},
+	"LTL": {Decimal: ".", Thousand: ",", Code: "LTL", Fraction: 2, Grapheme: "Lt", Template: "$1", Name: "Lithuanian Litas", NumericCode: "440", Historic: true, ValidUntil: date(2015, 1, 1), Successor: &Redenomination{Code: "EUR", Rate: decimal.RequireFromString("3.4528")}},
+	"LVL": {Decimal: ".", Thousand: ",", Code: "LVL", Fraction: 2, Grapheme: "Ls", Template: "1 $", Name: "Latvian Lats", NumericCode: "428", Historic: true, ValidUntil: date(2014, 2, 1), Successor: &Redenomination{Code: "EUR", Rate: decimal.RequireFromString("0.702804")}},
+	"LYD": {Decimal: ".", Thousand: ",", Code: "LYD", Fraction: 3, Grapheme: ".\u062f.\u0644", Template: "1 $", Name: "Libyan Dinar", NumericCode: "434"},
+	"MAD": {Decimal: ".", Thousand: ",", Code: "MAD", Fraction: 2, Grapheme: ".\u062f.\u0645", Template: "1 $", Name: "Moroccan Dirham", NumericCode: "504"},
+	"MDL": {Decimal: ".", Thousand: ",", Code: "MDL", Fraction: 2, Grapheme: "L", Template: "1 $", Name: "Moldovan Leu", NumericCode: "498"},
+	"MGA": {Decimal: ".", Thousand: ",", Code: "MGA", Fraction: 2, Grapheme: "Ar", Template: "$1", Name: "Malagasy Ariary", NumericCode: "969"},
+	"MKD": {Decimal: ".", Thousand: ",", Code: "MKD", Fraction: 2, Grapheme: "\u0434\u0435\u043d", Template: "$1", Name: "Denar", NumericCode: "807"},
+	"MMK": {Decimal: ".", Thousand: ",", Code: "MMK", Fraction: 2, Grapheme: "K", Template: "$1", Name: "Kyat", NumericCode: "104"},
+	"MNT": {Decimal: ".", Thousand: ",", Code: "MNT", Fraction: 2, Grapheme: "\u20ae", Template: "$1", Name: "Tugrik", NumericCode: "496"},
+	"MOP": {Decimal: ".", Thousand: ",", Code: "MOP", Fraction: 2, Grapheme: "MOP$", Template: "$1", Name: "Pataca", NumericCode: "446"},
+	"MRO": {Decimal: ".", Thousand: ",", Code: "MRO", Fraction: 2, Grapheme: "UM", Template: "1 $", Name: "Ouguiya", NumericCode: "478", Historic: true, ValidUntil: date(2018, 1, 1), Successor: &Redenomination{Code: "MRU", Rate: decimal.RequireFromString("10")}},
+	"MRU": {Decimal: ".", Thousand: ",", Code: "MRU", Fraction: 2, Grapheme: "UM", Template: "1 $", Name: "Ouguiya", NumericCode: "929", ValidFrom: date(2018, 1, 1)},
+	"MUR": {Decimal: ".", Thousand: ",", Code: "MUR", Fraction: 2, Grapheme: "\u20a8", Template: "$1", Name: "Mauritius Rupee", NumericCode: "480"},
+	"MVR": {Decimal: ".", Thousand: ",", Code: "MVR", Fraction: 2, Grapheme: "Rf", Template: "$1", Name: "Rufiyaa", NumericCode: "462"},
+	"MWK": {Decimal: ".", Thousand: ",", Code: "MWK", Fraction: 2, Grapheme: "MK", Template: "$1", Name: "Malawi Kwacha", NumericCode: "454"},
+	"MXN": {Decimal: ".", Thousand: ",", Code: "MXN", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Mexican Peso", NumericCode: "484"},
+	"MXV": {Decimal: ".", Thousand: ",", Code: "MXV", Fraction: 2, Grapheme: "MXV", Template: "1 $", Name: "Mexican Unidad de Inversion (UDI)", NumericCode: "979"},
+	"MYR": {Decimal: ".", Thousand: ",", Code: "MYR", Fraction: 2, Grapheme: "RM", Template: "$1", Name: "Malaysian Ringgit", NumericCode: "458"},
+	"MZN": {Decimal: ".", Thousand: ",", Code: "MZN", Fraction: 2, Grapheme: "MT", Template: "$1", Name: "Mozambique Metical", NumericCode: "943"},
+	"NAD": {Decimal: ".", Thousand: ",", Code: "NAD", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Namibia Dollar", NumericCode: "516"},
+	"NGN": {Decimal: ".", Thousand: ",", Code: "NGN", Fraction: 2, Grapheme: "\u20a6", Template: "$1", Name: "Naira", NumericCode: "566"},
+	"NIO": {Decimal: ".", Thousand: ",", Code: "NIO", Fraction: 2, Grapheme: "C$", Template: "$1", Name: "Cordoba Oro", NumericCode: "558"},
+	"NOK": {Decimal: ".", Thousand: ",", Code: "NOK", Fraction: 2, Grapheme: "kr", Template: "1 $", Name: "Norwegian Krone", NumericCode: "578", CashIncrement: 100},
+	"NPR": {Decimal: ".", Thousand: ",", Code: "NPR", Fraction: 2, Grapheme: "\u20a8", Template: "$1", Name: "Nepalese Rupee", NumericCode: "524"},
+	"NZD": {Decimal: ".", Thousand: ",", Code: "NZD", Fraction: 2, Grapheme: "$", Template: "$1", Name: "New Zealand Dollar", NumericCode: "554", CashIncrement: 10},
+	"OMR": {Decimal: ".", Thousand: ",", Code: "OMR", Fraction: 3, Grapheme: "\ufdfc", Template: "1 $", Name: "Rial Omani", NumericCode: "512"},
+	"PAB": {Decimal: ".", Thousand: ",", Code: "PAB", Fraction: 2, Grapheme: "B/.", Template: "$1", Name: "Balboa", NumericCode: "590"},
+	"PEN": {Decimal: ".", Thousand: ",", Code: "PEN", Fraction: 2, Grapheme: "S/", Template: "$1", Name: "Sol", NumericCode: "604"},
+	"PGK": {Decimal: ".", Thousand: ",", Code: "PGK", Fraction: 2, Grapheme: "K", Template: "$1", Name: "Kina", NumericCode: "598"},
+	"PHP": {Decimal: ".", Thousand: ",", Code: "PHP", Fraction: 2, Grapheme: "\u20b1", Template: "$1", Name: "Philippine Peso", NumericCode: "608"},
+	"PKR": {Decimal: ".", Thousand: ",", Code: "PKR", Fraction: 2, Grapheme: "\u20a8", Template: "$1", Name: "Pakistan Rupee", NumericCode: "586"},
+	"PLN": {Decimal: ".", Thousand: ",", Code: "PLN", Fraction: 2, Grapheme: "z\u0142", Template: "1 $", Name: "Zloty", NumericCode: "985"},
+	"PYG": {Decimal: ".", Thousand: ",", Code: "PYG", Fraction: 0, Grapheme: "Gs", Template: "1$", Name: "Guarani", NumericCode: "600"},
+	"QAR": {Decimal: ".", Thousand: ",", Code: "QAR", Fraction: 2, Grapheme: "\ufdfc", Template: "1 $", Name: "Qatari Rial", NumericCode: "634"},
+	"RON": {Decimal: ".", Thousand: ",", Code: "RON", Fraction: 2, Grapheme: "lei", Template: "$1", Name: "Romanian Leu", NumericCode: "946"},
+	"RSD": {Decimal: ".", Thousand: ",", Code: "RSD", Fraction: 2, Grapheme: "\u0414\u0438\u043d.", Template: "$1", Name: "Serbian Dinar", NumericCode: "941"},
+	"RUB": {Decimal: ".", Thousand: ",", Code: "RUB", Fraction: 2, Grapheme: "\u20bd", Template: "1 $", Name: "Russian Ruble", NumericCode: "643", ValidFrom: date(1998, 1, 1)},
+	"RUR": {Decimal: ".", Thousand: ",", Code: "RUR", Fraction: 2, Grapheme: "\u20bd", Template: "1 $", Name: "Russian Ruble", NumericCode: "810", Historic: true, ValidUntil: date(1998, 1, 1), Successor: &Redenomination{Code: "RUB", Rate: decimal.RequireFromString("1000")}},
+	"RWF": {Decimal: ".", Thousand: ",", Code: "RWF", Fraction: 0, Grapheme: "FRw", Template: "1 $", Name: "Rwanda Franc", NumericCode: "646"},
+	"SAR": {Decimal: ".", Thousand: ",", Code: "SAR", Fraction: 2, Grapheme: "\ufdfc", Template: "1 $", Name: "Saudi Riyal", NumericCode: "682"},
+	"SBD": {Decimal: ".", Thousand: ",", Code: "SBD", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Solomon Islands Dollar", NumericCode: "090"},
+	"SCR": {Decimal: ".", Thousand: ",", Code: "SCR", Fraction: 2, Grapheme: "\u20a8", Template: "$1", Name: "Seychelles Rupee", NumericCode: "690"},
+	"SDG": {Decimal: ".", Thousand: ",", Code: "SDG", Fraction: 2, Grapheme: "\u062c.\u0633.", Template: "1 $", Name: "Sudanese Pound", NumericCode: "938"},
+	"SEK": {Decimal: ".", Thousand: ",", Code: "SEK", Fraction: 2, Grapheme: "kr", Template: "1 $", Name: "Swedish Krona", NumericCode: "752", CashIncrement: 100},
+	"SGD": {Decimal: ".", Thousand: ",", Code: "SGD", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Singapore Dollar", NumericCode: "702"},
+	"SHP": {Decimal: ".", Thousand: ",", Code: "SHP", Fraction: 2, Grapheme: "\u00a3", Template: "$1", Name: "Saint Helena Pound", NumericCode: "654"},
+	"SLE": {Decimal: ".", Thousand: ",", Code: "SLE", Fraction: 2, Grapheme: "Le", Template: "$1", Name: "Leone", NumericCode: "925", ValidFrom: date(2022, 7, 1)},
+	"SLL": {Decimal: ".", Thousand: ",", Code: "SLL", Fraction: 2, Grapheme: "Le", Template: "$1", Name: "Leone", NumericCode: "694", Historic: true, ValidUntil: date(2024, 1, 1), Successor: &Redenomination{Code: "SLE", Rate: decimal.RequireFromString("1000")}},
+	"SOS": {Decimal: ".", Thousand: ",", Code: "SOS", Fraction: 2, Grapheme: "S", Template: "$1", Name: "Somali Shilling", NumericCode: "706"},
+	"SRD": {Decimal: ".", Thousand: ",", Code: "SRD", Fraction: 2, Grapheme: "$", Template: "$1", Name: "Surinam Dollar", NumericCode: "968"},
+	"SSP": {Decimal: ".", Thousand: ",", Code: "SSP", Fraction: 2, Grapheme: "\u00a3", Template: "$1", Name: "South Sudanese Pound", NumericCode: "728"},
+	"STD": {Decimal: ".", Thousand: ",", Code: "STD", Fraction: 2, Grapheme: "Db", Template: "$1", Name: "Dobra", NumericCode: "678", Historic: true, ValidUntil: date(2018, 1, 1), Successor: &Redenomination{Code: "STN", Rate: decimal.RequireFromString("1000")}},
+	"STN": {Decimal: ".", Thousand: ",", Code: "STN", Fraction: 2, Grapheme: "Db", Template: "$1", Name: "Dobra", NumericCode: "930", ValidFrom: date(2018, 1, 1)},
+	"SVC": {Decimal: ".", Thousand: ",", Code: "SVC", Fraction: 2, Grapheme: "$", Template: "$1", Name: "El Salvador Colon", NumericCode: "222"},
+	"SYP": {Decimal: ".", Thousand: ",", Code: "SYP", Fraction: 2, Grapheme: "\u00a3", Template: "$1", Name: "Syrian Pound", NumericCode: "760"},
+	"SZL": {Decimal: ".", Thousand: ",", Code: "SZL", Fraction: 2, Grapheme: "E", Template: "$1", Name: "Lilangeni", NumericCode: "748"},
+	"THB": {Decimal: ".", Thousand: ",", Code: "THB", Fraction: 2, Grapheme: "\u0e3f", Template: "$1", Name: "Baht", NumericCode: "764"},
+	"TJS": {Decimal: ".", Thousand: ",", Code: "TJS", Fraction: 2, Grapheme: "SM", Template: "1 $", Name: "Somoni", NumericCode: "972"},
+	"TMT": {Decimal: ".", Thousand: ",", Code: "TMT", Fraction: 2, Grapheme: "m", Template: "1 $", Name: "Turkmenistan New Manat", NumericCode: "934"},
+	"TND": {Decimal: ".", Thousand: ",", Code: "TND", Fraction: 3, Grapheme: ".\u062f.\u062a", Template: "1 $", Name: "Tunisian Dinar", NumericCode: "788"},
+	"TOP": {Decimal: ".", Thousand: ",", Code: "TOP", Fraction: 2, Grapheme: "T$", Template: "$1", Name: "Pa\u2019anga", NumericCode: "776"},
+	"TRL": {Decimal: ".", Thousand: ",", Code: "TRL", Fraction: 0, Grapheme: "\u20a4", Template: "$1", Name: "Old Turkish Lira", NumericCode: "792", Historic: true, ValidUntil: date(2006, 1, 1), Successor: &Redenomination{Code: "TRY", Rate: decimal.RequireFromString("1000000")}},
+	"TRY": {Decimal: ".", Thousand: ",", Code: "TRY", Fraction: 2, Grapheme: "\u20ba", Template: "$1", Name: "Turkish Lira", NumericCode: "949", ValidFrom: date(2005, 1, 1)},
+	"TTD": {Decimal: ".", Thousand: ",", Code: "TTD", Fraction: 2, Grapheme: "TT$", Template: "$1", Name: "Trinidad and Tobago Dollar", NumericCode: "780"},
+	"TWD": {Decimal: ".", Thousand: ",", Code: "TWD", Fraction: 2, Grapheme: "NT$", Template: "$1", Name: "New Taiwan Dollar", NumericCode: "901", CashIncrement: 100},
+	"TZS": {Decimal: ".", Thousand: ",", Code: "TZS", Fraction: 2, Grapheme: "TSh", Template: "$1", Name: "Tanzanian Shilling", NumericCode: "834"},
+	"UAH": {Decimal: ".", Thousand: ",", Code: "UAH", Fraction: 2, Grapheme: "\u20b4", Template: "$1", Name: "Hryvnia", NumericCode: "980"},
+	"UGX": {Decimal: ".", Thousand: ",", Code: "UGX", Fraction: 0, Grapheme: "USh", Template: "$1", Name: "Uganda Shilling", NumericCode: "800"},
+	"USD": {Decimal: ".", Thousand: ",", Code: "USD", Fraction: 2, Grapheme: "$", Template: "$1", Name: "US Dollar", NumericCode: "840"},
+	"USN": {Decimal: ".", Thousand: ",", Code: "USN", Fraction: 2, Grapheme: "$", Template: "$1", Name: "US Dollar (Next day)", NumericCode: "997"},
+	"UYI": {Decimal: ".", Thousand: ",", Code: "UYI", Fraction: 0, Grapheme: "UYI", Template: "1 $", Name: "Uruguay Peso en Unidades Indexadas (UI)", NumericCode: "940"},
+	"UYU": {Decimal: ".", Thousand: ",", Code: "UYU", Fraction: 2, Grapheme: "$U", Template: "$1", Name: "Peso Uruguayo", NumericCode: "858"},
+	"UYW": {Decimal: ".", Thousand: ",", Code: "UYW", Fraction: 4, Grapheme: "UYW", Template: "1 $", Name: "Unidad Previsional", NumericCode: "927"},
+	"UZS": {Decimal: ".", Thousand: ",", Code: "UZS", Fraction: 2, Grapheme: "so\u2019m", Template: "$1", Name: "Uzbekistan Sum", NumericCode: "860"},
+	"VED": {Decimal: ".", Thousand: ",", Code: "VED", Fraction: 2, Grapheme: "Bs.D", Template: "$1", Name: "Bol\u00edvar Soberano", NumericCode: "926"},
+	"VEF": {Decimal: ".", Thousand: ",", Code: "VEF", Fraction: 2, Grapheme: "Bs", Template: "$1", Name: "Bolivar", NumericCode: "937", Historic: true, ValidUntil: date(2018, 9, 1), Successor: &Redenomination{Code: "VES", Rate: decimal.RequireFromString("100000")}},
+	"VES": {Decimal: ".", Thousand: ",", Code: "VES", Fraction: 2, Grapheme: "Bs.S", Template: "$1", Name: "Bol\u00edvar Soberano", NumericCode: "928", ValidFrom: date(2018, 8, 20)},
+	"VND": {Decimal: ".", Thousand: ",", Code: "VND", Fraction: 0, Grapheme: "\u20ab", Template: "1 $", Name: "Dong", NumericCode: "704"},
+	"VUV": {Decimal: ".", Thousand: ",", Code: "VUV", Fraction: 0, Grapheme: "VT", Template: "1 $", Name: "Vatu", NumericCode: "548"},
+	"WST": {Decimal: ".", Thousand: ",", Code: "WST", Fraction: 2, Grapheme: "WS$", Template: "$1", Name: "Tala", NumericCode: "882"},
+	"XAF": {Decimal: ".", Thousand: ",", Code: "XAF", Fraction: 0, Grapheme: "FCFA", Template: "1 $", Name: "CFA Franc BEAC", NumericCode: "950"},
+	"XAG": {Decimal: ".", Thousand: ",", Code: "XAG", Fraction: 0, Grapheme: "XAG", Template: "1 $", Name: "Silver", NumericCode: "961"},
+	"XAU": {Decimal: ".", Thousand: ",", Code: "XAU", Fraction: 0, Grapheme: "XAU", Template: "1 $", Name: "Gold", NumericCode: "959"},
+	"XBA": {Decimal: ".", Thousand: ",", Code: "XBA", Fraction: 0, Grapheme: "XBA", Template: "1 $", Name: "Bond Markets Unit European Composite Unit (EURCO)", NumericCode: "955"},
+	"XBB": {Decimal: ".", Thousand: ",", Code: "XBB", Fraction: 0, Grapheme: "XBB", Template: "1 $", Name: "Bond Markets Unit European Monetary Unit (E.M.U.-6)", NumericCode: "956"},
+	"XBC": {Decimal: ".", Thousand: ",", Code: "XBC", Fraction: 0, Grapheme: "XBC", Template: "1 $", Name: "Bond Markets Unit European Unit of Account 9 (E.U.A.-9)", NumericCode: "957"},
+	"XBD": {Decimal: ".", Thousand: ",", Code: "XBD", Fraction: 0, Grapheme: "XBD", Template: "1 $", Name: "Bond Markets Unit European Unit of Account 17 (E.U.A.-17)", NumericCode: "958"},
+	"XCD": {Decimal: ".", Thousand: ",", Code: "XCD", Fraction: 2, Grapheme: "$", Template: "$1", Name: "East Caribbean Dollar", NumericCode: "951"},
+	"XDR": {Decimal: ".", Thousand: ",", Code: "XDR", Fraction: 0, Grapheme: "SDR", Template: "1 $", Name: "SDR (Special Drawing Right)", NumericCode: "960"},
+	"XOF": {Decimal: ".", Thousand: ",", Code: "XOF", Fraction: 0, Grapheme: "CFA", Template: "1 $", Name: "CFA Franc BCEAO", NumericCode: "952"},
+	"XPD": {Decimal: ".", Thousand: ",", Code: "XPD", Fraction: 0, Grapheme: "XPD", Template: "1 $", Name: "Palladium", NumericCode: "964"},
+	"XPF": {Decimal: ".", Thousand: ",", Code: "XPF", Fraction: 0, Grapheme: "\u20a3", Template: "1 $", Name: "CFP Franc", NumericCode: "953"},
+	"XPT": {Decimal: ".", Thousand: ",", Code: "XPT", Fraction: 0, Grapheme: "XPT", Template: "1 $", Name: "Platinum", NumericCode: "962"},
+	"XSU": {Decimal: ".", Thousand: ",", Code: "XSU", Fraction: 0, Grapheme: "Sucre", Template: "1 $", Name: "Sucre", NumericCode: "994"},
+	"XTS": {Decimal: ".", Thousand: ",", Code: "XTS", Fraction: 0, Grapheme: "XTS", Template: "1 $", Name: "Codes specifically reserved for testing purposes", NumericCode: "963"},
+	"XUA": {Decimal: ".", Thousand: ",", Code: "XUA", Fraction: 0, Grapheme: "XUA", Template: "1 $", Name: "ADB Unit of Account", NumericCode: "965"},
+	"XXX": {Decimal: ".", Thousand: ",", Code: "XXX", Fraction: 0, Grapheme: "XXX", Template: "1 $", Name: "The codes assigned for transactions where no currency is involved", NumericCode: "999"},
+	"YER": {Decimal: ".", Thousand: ",", Code: "YER", Fraction: 2, Grapheme: "\ufdfc", Template: "1 $", Name: "Yemeni Rial", NumericCode: "886"},
+	"ZAR": {Decimal: ".", Thousand: ",", Code: "ZAR", Fraction: 2, Grapheme: "R", Template: "$1", Name: "Rand", NumericCode: "710"},
+	"ZMW": {Decimal: ".", Thousand: ",", Code: "ZMW", Fraction: 2, Grapheme: "ZK", Template: "$1", Name: "Zambian Kwacha", NumericCode: "967"},
+	"ZWD": {Decimal: ".", Thousand: ",", Code: "ZWD", Fraction: 2, Grapheme: "Z$", Template: "$1", Name: "Zimbabwe Dollar", NumericCode: "716", Historic: true, ValidUntil: date(2006, 9, 1)},
+	"ZWG": {Decimal: ".", Thousand: ",", Code: "ZWG", Fraction: 2, Grapheme: "ZiG", Template: "$1", Name: "Zimbabwe Gold", NumericCode: "924"},
+	"ZWL": {Decimal: ".", Thousand: ",", Code: "ZWL", Fraction: 2, Grapheme: "Z$", Template: "$1", Name: "Zimbabwe Dollar", NumericCode: "932", Historic: true, ValidUntil: date(2024, 10, 1)},
+}
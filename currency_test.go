@@ -3,6 +3,7 @@ package money
 import (
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestCurrency_Get(t *testing.T) {
@@ -86,3 +87,102 @@ func TestCurrency_GetNonExistingCurrency(t *testing.T) {
 		t.Errorf("Unexpected currency returned %+v", currency)
 	}
 }
+
+func TestCurrency_GetCurrencyByNumeric(t *testing.T) {
+	tcs := []struct {
+		numeric  string
+		expected string
+	}{
+		{"978", "EUR"},
+		{"840", "USD"},
+		{"036", "AUD"},
+		{"36", "AUD"},
+		{"756", "CHF"},
+		{"952", "XOF"},
+	}
+
+	for _, tc := range tcs {
+		c := GetCurrencyByNumeric(tc.numeric)
+
+		if c == nil || c.Code != tc.expected {
+			t.Errorf("Expected %s for numeric code %s got %+v", tc.expected, tc.numeric, c)
+		}
+	}
+
+	if c := GetCurrencyByNumeric("000"); c != nil {
+		t.Errorf("Unexpected currency returned %+v", c)
+	}
+}
+
+func TestCurrency_ISO4217(t *testing.T) {
+	tcs := []struct {
+		code     string
+		numeric  string
+		name     string
+		fraction int
+		historic bool
+	}{
+		{"EUR", "978", "Euro", 2, false},
+		{"CLF", "990", "Unidad de Fomento", 4, false},
+		{"ISK", "352", "Iceland Krona", 0, false},
+		{"UYU", "858", "Peso Uruguayo", 2, false},
+		{"XAF", "950", "CFA Franc BEAC", 0, false},
+		{"SDG", "938", "Sudanese Pound", 2, false},
+		{"LTL", "440", "Lithuanian Litas", 2, true},
+		{"TRL", "792", "Old Turkish Lira", 0, true},
+	}
+
+	for _, tc := range tcs {
+		c := GetCurrency(tc.code)
+
+		if c == nil {
+			t.Errorf("Expected currency %s to exist", tc.code)
+			continue
+		}
+
+		if c.NumericCode != tc.numeric || c.Name != tc.name || c.Fraction != tc.fraction || c.Historic != tc.historic {
+			t.Errorf("Expected %s to be %+v got %+v", tc.code, tc, c)
+		}
+	}
+}
+
+func TestCurrency_RegisterCurrency(t *testing.T) {
+	r := NewEmptyRegistry()
+	r.Register(Currency{Code: "TST", NumericCode: "901", Fraction: 2, Grapheme: "T", Template: "$1"})
+
+	if c := r.GetCurrencyByNumeric("901"); c == nil || c.Code != "TST" {
+		t.Errorf("Expected TST got %+v", c)
+	}
+
+	r.AddCurrency("TST", "T", "$1", ".", ",", 2)
+
+	if c := r.GetCurrencyByNumeric("901"); c != nil {
+		t.Errorf("Unexpected currency returned %+v", c)
+	}
+
+	r.Register(Currency{Code: "OLD", NumericCode: "902", Historic: true})
+	r.Register(Currency{Code: "NEW", NumericCode: "902"})
+	r.Register(Currency{Code: "OLX", NumericCode: "902", Historic: true})
+
+	if c := r.GetCurrencyByNumeric("902"); c == nil || c.Code != "NEW" {
+		t.Errorf("Expected NEW got %+v", c)
+	}
+}
+
+func TestCurrency_ValidAt(t *testing.T) {
+	ltl := GetCurrency("LTL")
+
+	if !ltl.ValidAt(time.Date(2014, 12, 31, 23, 59, 0, 0, time.UTC)) {
+		t.Errorf("Expected LTL to be valid in 2014")
+	}
+	if ltl.ValidAt(time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected LTL to be withdrawn in 2015")
+	}
+	if ltl.Successor == nil || ltl.Successor.Code != "EUR" || ltl.Successor.Rate.String() != "3.4528" {
+		t.Errorf("Expected LTL successor EUR at 3.4528 got %+v", ltl.Successor)
+	}
+
+	if !GetCurrency("USD").ValidAt(time.Time{}) {
+		t.Errorf("Expected USD to be valid at any time")
+	}
+}
@@ -0,0 +1,214 @@
+// Package ecb provides money.RateProvider of the European Central Bank euro foreign exchange
+// reference rates. Rates are loaded from eurofxref daily or historic files in XML or CSV format
+// which are downloaded separately, e.g.
+//
+//	https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml
+//	https://www.ecb.europa.eu/stats/eurofxref/eurofxref-hist.zip
+//
+// Rates are quoted against EUR, rates between other currencies are derived through EUR.
+package ecb
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/amanbolat/go-money"
+	"github.com/shopspring/decimal"
+)
+
+// base is the currency all reference rates are quoted against
+const base = "EUR"
+
+// Provider is money.RateProvider of ECB reference rates. It is safe for concurrent use
+// once loaded and configured
+type Provider struct {
+	days     []day
+	fallback int
+}
+
+// day holds reference rates published on the date, rates are units of currency for 1 EUR
+type day struct {
+	date  time.Time
+	rates map[string]decimal.Decimal
+}
+
+// LoadXML reads rates from eurofxref daily, 90 days or historic XML file
+func LoadXML(r io.Reader) (*Provider, error) {
+	var doc struct {
+		Cubes []struct {
+			Time  string `xml:"time,attr"`
+			Rates []struct {
+				Currency string `xml:"currency,attr"`
+				Rate     string `xml:"rate,attr"`
+			} `xml:"Cube"`
+		} `xml:"Cube>Cube"`
+	}
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("ecb: %w", err)
+	}
+
+	days := map[time.Time]map[string]decimal.Decimal{}
+	for _, cube := range doc.Cubes {
+		date, err := parseDate(cube.Time)
+		if err != nil {
+			return nil, err
+		}
+
+		rates := map[string]decimal.Decimal{}
+		for _, r := range cube.Rates {
+			if err := addRate(rates, r.Currency, r.Rate); err != nil {
+				return nil, err
+			}
+		}
+		days[date] = rates
+	}
+
+	return newProvider(days)
+}
+
+// LoadCSV reads rates from eurofxref daily or historic CSV file
+func LoadCSV(r io.Reader) (*Provider, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("ecb: %w", err)
+	}
+	if len(header) == 0 || strings.TrimSpace(header[0]) != "Date" {
+		return nil, fmt.Errorf("ecb: CSV file must start with Date column")
+	}
+
+	days := map[time.Time]map[string]decimal.Decimal{}
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("ecb: %w", err)
+		}
+
+		date, err := parseDate(record[0])
+		if err != nil {
+			return nil, err
+		}
+
+		rates := map[string]decimal.Decimal{}
+		for i := 1; i < len(record) && i < len(header); i++ {
+			code, rate := strings.TrimSpace(header[i]), strings.TrimSpace(record[i])
+			if code == "" || rate == "" || rate == "N/A" {
+				continue
+			}
+			if err := addRate(rates, code, rate); err != nil {
+				return nil, err
+			}
+		}
+		days[date] = rates
+	}
+
+	return newProvider(days)
+}
+
+// SetFallback sets the number of calendar days Rate looks back for the previous business day
+// if no rates are published on the requested date, e.g. 3 covers weekends and 5 covers Easter.
+// Zero fallback requires rates of the exact date. SetFallback must not be called concurrently with Rate
+func (p *Provider) SetFallback(days int) {
+	p.fallback = days
+}
+
+// Fallback returns the number of days Rate looks back for the previous business day
+func (p *Provider) Fallback() int {
+	return p.fallback
+}
+
+// Dates returns dates rates are published for in ascending order
+func (p *Provider) Dates() []time.Time {
+	dates := make([]time.Time, len(p.days))
+	for i, d := range p.days {
+		dates[i] = d.date
+	}
+
+	return dates
+}
+
+// Rate implements money.RateProvider. Rates of the calendar date of at are used, or rates of
+// the previous business day within the fallback. Rates between currencies other than EUR are
+// derived through EUR. Error wrapping money.ErrRateNotFound is returned if rates are missing
+func (p *Provider) Rate(from, to *money.Currency, at time.Time) (decimal.Decimal, error) {
+	date := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+
+	i := sort.Search(len(p.days), func(i int) bool { return p.days[i].date.After(date) }) - 1
+	if i < 0 || date.Sub(p.days[i].date) > time.Duration(p.fallback)*24*time.Hour {
+		return decimal.Zero, fmt.Errorf("%w: %s/%s on %s", money.ErrRateNotFound, from.Code, to.Code, date.Format("2006-01-02"))
+	}
+	d := p.days[i]
+
+	fromRate, ok := d.rate(from.Code)
+	if !ok {
+		return decimal.Zero, fmt.Errorf("%w: %s on %s", money.ErrRateNotFound, from.Code, d.date.Format("2006-01-02"))
+	}
+	toRate, ok := d.rate(to.Code)
+	if !ok {
+		return decimal.Zero, fmt.Errorf("%w: %s on %s", money.ErrRateNotFound, to.Code, d.date.Format("2006-01-02"))
+	}
+
+	if from.Code == base {
+		return toRate, nil
+	}
+
+	return toRate.Div(fromRate), nil
+}
+
+func (d day) rate(code string) (decimal.Decimal, bool) {
+	if code == base {
+		return decimal.New(1, 0), true
+	}
+
+	r, ok := d.rates[code]
+
+	return r, ok
+}
+
+// newProvider returns Provider of rates by date
+func newProvider(days map[time.Time]map[string]decimal.Decimal) (*Provider, error) {
+	if len(days) == 0 {
+		return nil, fmt.Errorf("ecb: no rates found")
+	}
+
+	p := &Provider{}
+	for date, rates := range days {
+		p.days = append(p.days, day{date: date, rates: rates})
+	}
+	sort.Slice(p.days, func(i, j int) bool { return p.days[i].date.Before(p.days[j].date) })
+
+	return p, nil
+}
+
+func addRate(rates map[string]decimal.Decimal, code, rate string) error {
+	r, err := decimal.NewFromString(rate)
+	if err != nil || r.Sign() <= 0 {
+		return fmt.Errorf("ecb: invalid rate %q of %s", rate, code)
+	}
+	rates[code] = r
+
+	return nil
+}
+
+// parseDate reads dates like "2024-03-01" of historic files and "01 March 2024" of daily CSV file
+func parseDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range []string{"2006-01-02", "02 January 2006"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("ecb: invalid date %q", s)
+}
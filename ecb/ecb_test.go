@@ -0,0 +1,158 @@
+package ecb_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/amanbolat/go-money"
+	"github.com/amanbolat/go-money/ecb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const historicXML = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<gesmes:Sender>
+		<gesmes:name>European Central Bank</gesmes:name>
+	</gesmes:Sender>
+	<Cube>
+		<Cube time="2024-03-04">
+			<Cube currency="USD" rate="1.0850"/>
+			<Cube currency="JPY" rate="162.91"/>
+			<Cube currency="GBP" rate="0.85600"/>
+		</Cube>
+		<Cube time="2024-03-01">
+			<Cube currency="USD" rate="1.0838"/>
+			<Cube currency="JPY" rate="162.52"/>
+			<Cube currency="GBP" rate="0.85553"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+const dailyCSV = `Date, USD, JPY, BGN, GBP, 
+04 March 2024, 1.0850, 162.91, 1.9558, 0.85600, 
+`
+
+const historicCSV = `Date,USD,JPY,EEK,GBP,
+2024-03-04,1.0850,162.91,N/A,0.85600,
+2024-03-01,1.0838,162.52,N/A,0.85553,
+2007-12-31,1.4721,164.93,15.6466,0.73335,
+`
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestLoadXML(t *testing.T) {
+	p, err := ecb.LoadXML(strings.NewReader(historicXML))
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{date(2024, 3, 1), date(2024, 3, 4)}, p.Dates())
+
+	tcs := []struct {
+		from, to string
+		at       time.Time
+		expected string
+	}{
+		{"EUR", "USD", date(2024, 3, 1), "1.0838"},
+		{"EUR", "USD", time.Date(2024, 3, 4, 18, 30, 0, 0, time.UTC), "1.085"},
+		{"USD", "EUR", date(2024, 3, 4), "0.9216589861751152"},
+		{"GBP", "JPY", date(2024, 3, 1), "189.9641158112515049"},
+		{"USD", "USD", date(2024, 3, 1), "1"},
+	}
+
+	for _, tc := range tcs {
+		rate, err := p.Rate(money.GetCurrency(tc.from), money.GetCurrency(tc.to), tc.at)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, rate.String(), "%s/%s", tc.from, tc.to)
+	}
+}
+
+func TestLoadCSV(t *testing.T) {
+	p, err := ecb.LoadCSV(strings.NewReader(dailyCSV))
+	require.NoError(t, err)
+
+	rate, err := p.Rate(money.GetCurrency("EUR"), money.GetCurrency("BGN"), date(2024, 3, 4))
+	require.NoError(t, err)
+	assert.Equal(t, "1.9558", rate.String())
+
+	p, err = ecb.LoadCSV(strings.NewReader(historicCSV))
+	require.NoError(t, err)
+	assert.Len(t, p.Dates(), 3)
+
+	rate, err = p.Rate(money.GetCurrency("EEK"), money.GetCurrency("EUR"), date(2007, 12, 31))
+	require.NoError(t, err)
+	assert.Equal(t, "0.0639116485370624", rate.String())
+
+	_, err = p.Rate(money.GetCurrency("EEK"), money.GetCurrency("EUR"), date(2024, 3, 1))
+	assert.True(t, errors.Is(err, money.ErrRateNotFound))
+}
+
+func TestProvider_Fallback(t *testing.T) {
+	p, err := ecb.LoadCSV(strings.NewReader(historicCSV))
+	require.NoError(t, err)
+
+	eur, usd := money.GetCurrency("EUR"), money.GetCurrency("USD")
+
+	_, err = p.Rate(eur, usd, date(2024, 3, 3))
+	assert.True(t, errors.Is(err, money.ErrRateNotFound))
+
+	p.SetFallback(3)
+	assert.Equal(t, 3, p.Fallback())
+
+	rate, err := p.Rate(eur, usd, date(2024, 3, 3))
+	require.NoError(t, err)
+	assert.Equal(t, "1.0838", rate.String())
+
+	rate, err = p.Rate(eur, usd, date(2024, 3, 6))
+	require.NoError(t, err)
+	assert.Equal(t, "1.085", rate.String())
+
+	_, err = p.Rate(eur, usd, date(2024, 3, 8))
+	assert.True(t, errors.Is(err, money.ErrRateNotFound))
+
+	_, err = p.Rate(eur, usd, date(2000, 1, 1))
+	assert.True(t, errors.Is(err, money.ErrRateNotFound))
+}
+
+func TestProvider_Converter(t *testing.T) {
+	p, err := ecb.LoadXML(strings.NewReader(historicXML))
+	require.NoError(t, err)
+
+	c := money.NewConverter(p, 0)
+	r, rate, err := money.New(10000, "GBP").ConvertAt(money.GetCurrency("USD"), c, date(2024, 3, 1))
+	require.NoError(t, err)
+	assert.Equal(t, "126.68 USD", r.String())
+	assert.Equal(t, "1.2668170607693477", rate.String())
+}
+
+func TestLoadErrors(t *testing.T) {
+	tcs := []struct {
+		name string
+		load func() error
+	}{
+		{"empty XML", func() error {
+			_, err := ecb.LoadXML(strings.NewReader(`<Envelope><Cube></Cube></Envelope>`))
+			return err
+		}},
+		{"invalid XML", func() error { _, err := ecb.LoadXML(strings.NewReader(`<Envelope>`)); return err }},
+		{"invalid XML date", func() error {
+			_, err := ecb.LoadXML(strings.NewReader(`<Envelope><Cube><Cube time="01.03.2024"></Cube></Cube></Envelope>`))
+			return err
+		}},
+		{"invalid XML rate", func() error {
+			_, err := ecb.LoadXML(strings.NewReader(`<Envelope><Cube><Cube time="2024-03-01"><Cube currency="USD" rate="x"/></Cube></Cube></Envelope>`))
+			return err
+		}},
+		{"empty CSV", func() error { _, err := ecb.LoadCSV(strings.NewReader(``)); return err }},
+		{"CSV without rows", func() error { _, err := ecb.LoadCSV(strings.NewReader("Date, USD\n")); return err }},
+		{"CSV header", func() error { _, err := ecb.LoadCSV(strings.NewReader("USD\n1.08\n")); return err }},
+		{"invalid CSV rate", func() error { _, err := ecb.LoadCSV(strings.NewReader("Date,USD\n2024-03-01,-1\n")); return err }},
+	}
+
+	for _, tc := range tcs {
+		assert.Error(t, tc.load(), tc.name)
+	}
+}
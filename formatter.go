@@ -0,0 +1,105 @@
+package money
+
+import (
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// Formatter renders monetary amounts using grouping, decimal mark
+// and currency symbol placement rules
+type Formatter struct {
+	Fraction       int
+	Decimal        string
+	Thousand       string
+	Minus          string
+	Group          int
+	SecondaryGroup int
+	Grapheme       string
+	Template       string
+}
+
+// NewFormatter creates new Formatter with the given rules,
+// digits are grouped by three
+func NewFormatter(fraction int, decimal, thousand, grapheme, template string) *Formatter {
+	return &Formatter{
+		Fraction: fraction,
+		Decimal:  decimal,
+		Thousand: thousand,
+		Minus:    "-",
+		Group:    3,
+		Grapheme: grapheme,
+		Template: template,
+	}
+}
+
+// Format returns string representation of the amount.
+// Amount is rounded by Fraction, grouped and placed into Template,
+// where "1" is replaced by the number and "$" by the Grapheme
+func (f *Formatter) Format(amount decimal.Decimal) string {
+	str := f.formatNumber(amount.Abs())
+
+	str = strings.Replace(f.Template, "1", str, 1)
+	str = strings.Replace(str, "$", f.Grapheme, 1)
+
+	if amount.Sign() == -1 {
+		minus := f.Minus
+		if minus == "" {
+			minus = "-"
+		}
+		str = minus + str
+	}
+
+	return str
+}
+
+// formatNumber formats absolute amount without currency symbol
+func (f *Formatter) formatNumber(amount decimal.Decimal) string {
+	str := amount.StringFixed(int32(f.Fraction))
+
+	integer, fraction := str, ""
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		integer, fraction = str[:i], str[i+1:]
+	}
+
+	integer = f.group(integer)
+
+	if fraction == "" {
+		return integer
+	}
+
+	sep := f.Decimal
+	if sep == "" {
+		sep = "."
+	}
+
+	return integer + sep + fraction
+}
+
+// group inserts Thousand separator into the integer digits.
+// SecondaryGroup lets group digits like 12,34,567 for Indian locales
+func (f *Formatter) group(digits string) string {
+	size := f.Group
+	if f.Thousand == "" || size <= 0 || len(digits) <= size {
+		return digits
+	}
+
+	secondary := f.SecondaryGroup
+	if secondary <= 0 {
+		secondary = size
+	}
+
+	var parts []string
+	end := len(digits)
+	for end > 0 {
+		start := end - size
+		if start < 0 {
+			start = 0
+		}
+		parts = append([]string{digits[start:end]}, parts...)
+		end = start
+		size = secondary
+	}
+
+	return strings.Join(parts, f.Thousand)
+}
@@ -0,0 +1,113 @@
+package money_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/amanbolat/go-money"
+	"github.com/shopspring/decimal"
+)
+
+func TestFormatter_Format(t *testing.T) {
+	tcs := []struct {
+		formatter *money.Formatter
+		amount    decimal.Decimal
+		expected  string
+	}{
+		{money.NewFormatter(2, ".", ",", "$", "$1"), decimal.New(123456789, -2), "$1,234,567.89"},
+		{money.NewFormatter(2, ",", ".", "€", "1 $"), decimal.New(123456789, -2), "1.234.567,89 €"},
+		{money.NewFormatter(2, ".", ",", "$", "$1"), decimal.New(-100, -2), "-$1.00"},
+		{money.NewFormatter(2, ".", ",", "$", "$1"), decimal.New(999, -2), "$9.99"},
+		{money.NewFormatter(0, ".", ",", "¥", "$1"), decimal.New(1000, 0), "¥1,000"},
+		{money.NewFormatter(3, ".", "", "KD", "1 $"), decimal.New(1234567, -3), "1234.567 KD"},
+		{&money.Formatter{Fraction: 2, Decimal: ".", Thousand: ",", Group: 3, SecondaryGroup: 2, Grapheme: "₹", Template: "$1"}, decimal.New(123456789, -2), "₹12,34,567.89"},
+	}
+
+	for _, tc := range tcs {
+		r := tc.formatter.Format(tc.amount)
+
+		if r != tc.expected {
+			t.Errorf("Expected formatted %s to be %s got %s", tc.amount.String(), tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_FormatLocale(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		locale   string
+		expected string
+	}{
+		{123456789, "EUR", "de-DE", "1.234.567,89 €"},
+		{123456789, "EUR", "en-IE", "€1,234,567.89"},
+		{123456789, "EUR", "de_de", "1.234.567,89 €"},
+		{-123456789, "EUR", "de-DE", "-1.234.567,89 €"},
+		{123456789, "INR", "en-IN", "₹12,34,567.89"},
+		{123456789, "EUR", "xx-XX", "€1,234,567.89"},
+		{123456789, "EUR", "de-LU", "1.234.567,89 €"},
+		{123456789, "CHF", "de-CH", "CHF 1’234’567.89"},
+		{-123456789, "SEK", "sv-SE", "−1\u00a0234\u00a0567,89 kr"},
+		{123456789, "USD", "en-CA", "US$1,234,567.89"},
+		{123456, "JPY", "ja-JP", "￥123,456"},
+	}
+
+	for _, tc := range tcs {
+		m := money.New(tc.amount, tc.code)
+		r := m.FormatLocale(tc.locale)
+
+		if r != tc.expected {
+			t.Errorf("Expected %d %s formatted for %s to be %s got %s", tc.amount, tc.code, tc.locale, tc.expected, r)
+		}
+	}
+}
+
+func TestLocale_NarrowFormatter(t *testing.T) {
+	c := money.GetCurrency("USD")
+	l := money.GetLocale("en-CA")
+
+	r := l.Formatter(c).Format(decimal.New(100, -2))
+	if r != "US$1.00" {
+		t.Errorf("Expected %s got %s", "US$1.00", r)
+	}
+
+	r = l.NarrowFormatter(c).Format(decimal.New(100, -2))
+	if r != "$1.00" {
+		t.Errorf("Expected %s got %s", "$1.00", r)
+	}
+}
+
+func TestAddLocale(t *testing.T) {
+	money.AddLocale("xx_yy", ",", "'", "1$", 3, 0)
+	l := money.GetLocale("xx-YY")
+
+	if l == nil || l.Tag != "xx-YY" {
+		t.Fatalf("Expected locale xx-YY to be registered got %+v", l)
+	}
+
+	r := money.New(123456, "CHF").FormatLocale("xx-YY")
+	if r != "1'234,56CHF" {
+		t.Errorf("Expected %s got %s", "1'234,56CHF", r)
+	}
+}
+
+func TestAddLocale_Concurrency(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			money.AddLocale(fmt.Sprintf("x%d-ZZ", i), ",", ".", "1 $", 3, 0)
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			money.New(100, "EUR").FormatLocale(fmt.Sprintf("x%d-ZZ", i))
+		}(i)
+	}
+	wg.Wait()
+
+	if l := money.GetLocale("x9-ZZ"); l == nil {
+		t.Errorf("Expected locale x9-ZZ to be registered")
+	}
+}
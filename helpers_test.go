@@ -0,0 +1,8 @@
+package money_test
+
+import "github.com/shopspring/decimal"
+
+// mustDecimal parses decimal literal and panics if it is malformed
+func mustDecimal(s string) decimal.Decimal {
+	return decimal.RequireFromString(s)
+}
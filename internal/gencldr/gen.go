@@ -0,0 +1,323 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type numbersFile struct {
+	Main map[string]struct {
+		Numbers map[string]json.RawMessage `json:"numbers"`
+	} `json:"main"`
+}
+
+type currenciesFile struct {
+	Main map[string]struct {
+		Numbers struct {
+			Currencies map[string]map[string]string `json:"currencies"`
+		} `json:"numbers"`
+	} `json:"main"`
+}
+
+type parentsFile struct {
+	Supplemental struct {
+		ParentLocales struct {
+			ParentLocale map[string]string `json:"parentLocale"`
+		} `json:"parentLocales"`
+	} `json:"supplemental"`
+}
+
+type symbol struct {
+	standard string
+	narrow   string
+}
+
+type locale struct {
+	tag            string
+	decimal        string
+	group          string
+	minus          string
+	pattern        string
+	primaryGroup   int
+	secondaryGroup int
+	symbols        map[string]symbol
+}
+
+type source struct {
+	dir     string
+	parents map[string]string
+	codes   map[string]bool
+}
+
+// generate reads cldr-json directory and returns formatted source of cldr/data.go
+// with the given locales and symbols of the given currencies only
+func generate(dir string, tags, codes []string) ([]byte, error) {
+	src := &source{dir: dir, parents: map[string]string{}, codes: map[string]bool{}}
+	for _, code := range codes {
+		src.codes[code] = true
+	}
+	if err := src.loadParents(); err != nil {
+		return nil, err
+	}
+
+	var ls []*locale
+	for _, tag := range tags {
+		l, err := src.resolve(tag)
+		if err != nil {
+			return nil, err
+		}
+		ls = append(ls, l)
+	}
+
+	return render(ls)
+}
+
+func (s *source) loadParents() error {
+	data, err := ioutil.ReadFile(filepath.Join(s.dir, "cldr-core", "supplemental", "parentLocales.json"))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var f parentsFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return err
+	}
+	s.parents = f.Supplemental.ParentLocales.ParentLocale
+
+	return nil
+}
+
+// chain returns the tag followed by its parent locales up to the root locale,
+// "en-IE" gives [en-IE en-001 en und] when parentLocales.json says en-IE inherits from en-001
+func (s *source) chain(tag string) []string {
+	var chain []string
+	for tag != "" && tag != "root" && tag != "und" {
+		chain = append(chain, tag)
+
+		if p, ok := s.parents[tag]; ok {
+			tag = p
+			continue
+		}
+
+		i := strings.LastIndexByte(tag, '-')
+		if i < 0 {
+			break
+		}
+		tag = tag[:i]
+	}
+
+	return append(chain, "und")
+}
+
+// dirTags maps storefront tags to cldr-json directory names
+// when the directory is named differently
+var dirTags = map[string]string{
+	"en-US": "en",
+	"zh-CN": "zh",
+	"zh-HK": "zh-Hant-HK",
+	"zh-TW": "zh-Hant",
+}
+
+func dirTag(tag string) string {
+	if dir, ok := dirTags[tag]; ok {
+		return dir
+	}
+
+	return tag
+}
+
+func (s *source) resolve(tag string) (*locale, error) {
+	l := &locale{tag: tag, symbols: map[string]symbol{}}
+	chain := s.chain(dirTag(tag))
+
+	// walk from the most generic locale so specific ones override it
+	for i := len(chain) - 1; i >= 0; i-- {
+		if err := s.readNumbers(chain[i], l); err != nil {
+			return nil, err
+		}
+		if err := s.readCurrencies(chain[i], l); err != nil {
+			return nil, err
+		}
+	}
+
+	if l.decimal == "" || l.group == "" || l.pattern == "" {
+		return nil, fmt.Errorf("incomplete number data for locale %q", tag)
+	}
+	if l.minus == "" {
+		l.minus = "-"
+	}
+	l.primaryGroup, l.secondaryGroup = grouping(l.pattern)
+
+	return l, nil
+}
+
+func (s *source) readNumbers(tag string, l *locale) error {
+	data, err := ioutil.ReadFile(filepath.Join(s.dir, "cldr-numbers-full", "main", tag, "numbers.json"))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var f numbersFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return fmt.Errorf("%s: %v", tag, err)
+	}
+
+	numbers := f.Main[tag].Numbers
+	system := "latn"
+	if raw, ok := numbers["defaultNumberingSystem"]; ok {
+		if err := json.Unmarshal(raw, &system); err != nil {
+			return fmt.Errorf("%s: %v", tag, err)
+		}
+	}
+
+	var symbols map[string]string
+	if raw, ok := numbers["symbols-numberSystem-"+system]; ok {
+		if err := json.Unmarshal(raw, &symbols); err != nil {
+			return fmt.Errorf("%s: %v", tag, err)
+		}
+	}
+	if v := symbols["decimal"]; v != "" {
+		l.decimal = v
+	}
+	if v := symbols["group"]; v != "" {
+		l.group = v
+	}
+	if v := symbols["minusSign"]; v != "" {
+		l.minus = v
+	}
+
+	var formats map[string]json.RawMessage
+	if raw, ok := numbers["currencyFormats-numberSystem-"+system]; ok {
+		if err := json.Unmarshal(raw, &formats); err != nil {
+			return fmt.Errorf("%s: %v", tag, err)
+		}
+	}
+	if raw, ok := formats["standard"]; ok {
+		var pattern string
+		if err := json.Unmarshal(raw, &pattern); err != nil {
+			return fmt.Errorf("%s: %v", tag, err)
+		}
+		// only the positive sub pattern is used, sign is added by the formatter
+		l.pattern = strings.SplitN(pattern, ";", 2)[0]
+	}
+
+	return nil
+}
+
+func (s *source) readCurrencies(tag string, l *locale) error {
+	data, err := ioutil.ReadFile(filepath.Join(s.dir, "cldr-numbers-full", "main", tag, "currencies.json"))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var f currenciesFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return fmt.Errorf("%s: %v", tag, err)
+	}
+
+	for code, c := range f.Main[tag].Numbers.Currencies {
+		if !s.codes[code] {
+			continue
+		}
+
+		sym := l.symbols[code]
+		if v := c["symbol"]; v != "" {
+			sym.standard = v
+		}
+		if v := c["symbol-alt-narrow"]; v != "" {
+			sym.narrow = v
+		}
+		l.symbols[code] = sym
+	}
+
+	return nil
+}
+
+// grouping returns primary and secondary grouping sizes of the pattern,
+// "#,##,##0.00" gives 3 and 2. Secondary size is zero when equal to primary
+func grouping(pattern string) (int, int) {
+	start := strings.IndexAny(pattern, "#0")
+	end := strings.LastIndexAny(pattern, "#0")
+	if start < 0 {
+		return 0, 0
+	}
+
+	number := pattern[start : end+1]
+	if i := strings.IndexByte(number, '.'); i >= 0 {
+		number = number[:i]
+	}
+
+	groups := strings.Split(number, ",")
+	if len(groups) < 2 {
+		return 0, 0
+	}
+
+	primary := len(groups[len(groups)-1])
+	secondary := primary
+	if len(groups) > 2 {
+		secondary = len(groups[len(groups)-2])
+	}
+	if secondary == primary {
+		secondary = 0
+	}
+
+	return primary, secondary
+}
+
+func render(ls []*locale) ([]byte, error) {
+	sort.Slice(ls, func(i, j int) bool { return ls[i].tag < ls[j].tag })
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gencldr from cldr-json; DO NOT EDIT.\n\n")
+	buf.WriteString("package cldr\n\n")
+	buf.WriteString("var locales = map[string]*Locale{\n")
+	for _, l := range ls {
+		fmt.Fprintf(&buf, "\t%q: {\n", l.tag)
+		fmt.Fprintf(&buf, "\t\tTag: %q, Decimal: %+q, Group: %+q, Minus: %+q,\n", l.tag, l.decimal, l.group, l.minus)
+		fmt.Fprintf(&buf, "\t\tPrimaryGroup: %d, SecondaryGroup: %d, CurrencyPattern: %+q,\n", l.primaryGroup, l.secondaryGroup, l.pattern)
+		buf.WriteString("\t\tSymbols: map[string]Symbol{\n")
+
+		codes := make([]string, 0, len(l.symbols))
+		for code := range l.symbols {
+			codes = append(codes, code)
+		}
+		sort.Strings(codes)
+
+		for _, code := range codes {
+			s := l.symbols[code]
+			if s.standard == "" {
+				s.standard = code
+			}
+			if s.narrow == "" {
+				s.narrow = s.standard
+			}
+			// symbols equal to the code are the default
+			if s.standard == code && s.narrow == code {
+				continue
+			}
+			fmt.Fprintf(&buf, "\t\t\t%q: {Standard: %+q, Narrow: %+q},\n", code, s.standard, s.narrow)
+		}
+
+		buf.WriteString("\t\t},\n")
+		buf.WriteString("\t},\n")
+	}
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+// TestGenerate_Drift fails when cldr/data.go differs from the cldr-json files in the data directory,
+// run "go generate ./cldr" in the repository root to update it
+func TestGenerate_Drift(t *testing.T) {
+	expected, err := generate("data", split(defaultLocales), split(defaultCurrencies))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := ioutil.ReadFile("../../cldr/data.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(expected) != string(actual) {
+		t.Error("cldr/data.go is out of date, run go generate ./cldr")
+	}
+}
+
+func TestGenerate_Currencies(t *testing.T) {
+	data, err := generate("data", []string{"en-CA", "ar-AE"}, []string{"USD", "AED"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := string(data)
+	for _, expected := range []string{`"USD": {Standard: "US$", Narrow: "$"}`, `"AED": {Standard:`} {
+		if !strings.Contains(src, expected) {
+			t.Errorf("Expected %s to be generated", expected)
+		}
+	}
+
+	// currencies which are not requested are skipped
+	if strings.Contains(src, `"EUR":`) {
+		t.Error("Unexpected currency EUR")
+	}
+}
+
+func TestGrouping(t *testing.T) {
+	tcs := []struct {
+		pattern   string
+		primary   int
+		secondary int
+	}{
+		{"#,##0.00 ¤", 3, 0},
+		{"¤#,##,##0.00", 3, 2},
+		{"¤0.00", 0, 0},
+	}
+
+	for _, tc := range tcs {
+		primary, secondary := grouping(tc.pattern)
+		if primary != tc.primary || secondary != tc.secondary {
+			t.Errorf("Expected %s grouping %d,%d got %d,%d", tc.pattern, tc.primary, tc.secondary, primary, secondary)
+		}
+	}
+}
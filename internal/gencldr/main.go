@@ -0,0 +1,55 @@
+// Command gencldr generates cldr/data.go with number formats and currency symbols of locales.
+//
+// Data is read from cldr-json (https://github.com/unicode-org/cldr-json) files: numbers.json
+// and currencies.json of cldr-numbers-full and parentLocales.json of cldr-core. Locales inherit
+// data from their parents up to the root locale. Only symbols of currencies listed by the
+// -currencies flag are generated, symbols equal to the currency code are omitted.
+//
+// The data directory holds the subset of cldr-json used by the shipped locales and currencies,
+// a full cldr-json checkout can be passed with the -data flag as well.
+// Run "go generate ./cldr" in the repository root after updating files in the data directory.
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+// storefront locales shipped with the package
+const defaultLocales = "ar-AE,cs-CZ,da-DK,de,de-AT,de-CH,de-DE,el-GR,en,en-AU,en-CA,en-GB,en-IE,en-IN," +
+	"en-NZ,en-SG,en-US,en-ZA,es,es-ES,es-MX,fi-FI,fr,fr-BE,fr-CA,fr-CH,fr-FR,hu-HU,it-IT,ja-JP,ko-KR," +
+	"nb-NO,nl-BE,nl-NL,pl-PL,pt-BR,pt-PT,ro-RO,ru-RU,sv-SE,tr-TR,uk-UA,zh-CN,zh-HK,zh-TW"
+
+// currencies of the storefront markets whose symbols are shipped with the package
+const defaultCurrencies = "AED,AUD,BRL,CAD,CHF,CNY,CZK,DKK,EUR,GBP,GEL,HKD,HUF,ILS,INR,JPY,KRW,KZT,MXN,NGN," +
+	"NOK,NZD,PHP,PLN,RON,RUB,SEK,SGD,THB,TRY,TWD,UAH,USD,VND,XAF,XCD,XOF,XPF,ZAR"
+
+func main() {
+	dir := flag.String("data", "internal/gencldr/data", "cldr-json directory with cldr-core and cldr-numbers-full")
+	output := flag.String("o", "cldr/data.go", "output file")
+	tags := flag.String("locales", defaultLocales, "comma separated list of locales to generate")
+	codes := flag.String("currencies", defaultCurrencies, "comma separated list of currencies to generate symbols for")
+	flag.Parse()
+
+	data, err := generate(*dir, split(*tags), split(*codes))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(*output, data, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func split(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,311 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type entry struct {
+	Country    string `xml:"CtryNm"`
+	Name       string `xml:"CcyNm"`
+	Code       string `xml:"Ccy"`
+	Numeric    string `xml:"CcyNbr"`
+	MinorUnits string `xml:"CcyMnrUnts"`
+	Withdrawal string `xml:"WthdrwlDt"`
+}
+
+type listOne struct {
+	Entries []entry `xml:"CcyTbl>CcyNtry"`
+}
+
+type listThree struct {
+	Entries []entry `xml:"HstrcCcyTbl>HstrcCcyNtry"`
+}
+
+type overlay struct {
+	Grapheme string `json:"grapheme"`
+	Template string `json:"template"`
+	Decimal  string `json:"decimal"`
+	Thousand string `json:"thousand"`
+	Name     string `json:"name"`
+	Fraction *int   `json:"fraction"`
+
+	ValidFrom  string `json:"valid_from"`
+	ValidUntil string `json:"valid_until"`
+	Successor  string `json:"successor"`
+	Rate       string `json:"rate"`
+
+	CashIncrement int `json:"cash_increment"`
+}
+
+type currency struct {
+	Code     string
+	Numeric  string
+	Name     string
+	Fraction int
+	Grapheme string
+	Template string
+	Decimal  string
+	Thousand string
+	Historic bool
+
+	ValidFrom  string
+	ValidUntil string
+	Successor  string
+	Rate       string
+
+	CashIncrement int
+}
+
+// generate reads data directory and returns formatted source of currency_data.go
+func generate(dir string) ([]byte, error) {
+	cs, err := load(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return render(cs)
+}
+
+func load(dir string) ([]*currency, error) {
+	var one listOne
+	if err := readXML(filepath.Join(dir, "list-one.xml"), &one); err != nil {
+		return nil, err
+	}
+
+	var three listThree
+	if err := readXML(filepath.Join(dir, "list-three.xml"), &three); err != nil {
+		return nil, err
+	}
+
+	overlays := map[string]*overlay{}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "overlay.json"))
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &overlays); err != nil {
+		return nil, fmt.Errorf("overlay.json: %v", err)
+	}
+
+	cs := map[string]*currency{}
+
+	for _, e := range one.Entries {
+		// entries like ANTARCTICA have no universal currency
+		if e.Code == "" {
+			continue
+		}
+
+		fraction, err := minorUnits(e)
+		if err != nil {
+			return nil, err
+		}
+
+		c := &currency{Code: e.Code, Numeric: e.Numeric, Name: strings.TrimSpace(e.Name), Fraction: fraction}
+		if prev, ok := cs[e.Code]; ok {
+			if *prev != *c {
+				return nil, fmt.Errorf("list-one.xml: currency %s differs between countries", e.Code)
+			}
+			continue
+		}
+		cs[e.Code] = c
+	}
+
+	// list three has several entries for reused codes, the latest withdrawal wins
+	withdrawals := map[string]string{}
+	for _, e := range three.Entries {
+		o, ok := overlays[e.Code]
+		if !ok || e.Code == "" {
+			continue
+		}
+		if c, ok := cs[e.Code]; ok && !c.Historic {
+			continue
+		}
+		if e.Withdrawal < withdrawals[e.Code] {
+			continue
+		}
+		if o.Fraction == nil {
+			return nil, fmt.Errorf("overlay.json: historic currency %s has no fraction", e.Code)
+		}
+
+		until, err := withdrawal(e)
+		if err != nil {
+			return nil, err
+		}
+
+		withdrawals[e.Code] = e.Withdrawal
+		cs[e.Code] = &currency{Code: e.Code, Numeric: e.Numeric, Name: strings.TrimSpace(e.Name), Fraction: *o.Fraction, Historic: true, ValidUntil: until}
+	}
+
+	for code, o := range overlays {
+		c, ok := cs[code]
+		if !ok {
+			if o.Name == "" || o.Fraction == nil {
+				return nil, fmt.Errorf("overlay.json: local currency %s must have name and fraction", code)
+			}
+			c = &currency{Code: code, Name: o.Name, Fraction: *o.Fraction}
+			cs[code] = c
+		}
+
+		c.Grapheme, c.Template = o.Grapheme, o.Template
+		c.Decimal, c.Thousand = o.Decimal, o.Thousand
+		c.CashIncrement = o.CashIncrement
+
+		if o.CashIncrement < 0 {
+			return nil, fmt.Errorf("overlay.json: currency %s has negative cash increment", code)
+		}
+		if err := validity(code, c, o); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, c := range cs {
+		if _, ok := cs[c.Successor]; c.Successor != "" && !ok {
+			return nil, fmt.Errorf("overlay.json: currency %s has unknown successor %s", c.Code, c.Successor)
+		}
+	}
+
+	list := make([]*currency, 0, len(cs))
+	for _, c := range cs {
+		if c.Grapheme == "" {
+			c.Grapheme = c.Code
+		}
+		if c.Template == "" {
+			c.Template = "1 $"
+		}
+		if c.Decimal == "" {
+			c.Decimal = "."
+		}
+		if c.Thousand == "" {
+			c.Thousand = ","
+		}
+		list = append(list, c)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Code < list[j].Code })
+
+	return list, nil
+}
+
+// minorUnits returns currency fraction, "N.A." used for gold and other units is zero
+func minorUnits(e entry) (int, error) {
+	if e.MinorUnits == "N.A." {
+		return 0, nil
+	}
+
+	fraction, err := strconv.Atoi(e.MinorUnits)
+	if err != nil {
+		return 0, fmt.Errorf("list-one.xml: currency %s has invalid minor units %q", e.Code, e.MinorUnits)
+	}
+
+	return fraction, nil
+}
+
+// withdrawal returns the first day after the withdrawal month of historic currency
+func withdrawal(e entry) (string, error) {
+	if e.Withdrawal == "" {
+		return "", nil
+	}
+
+	t, err := time.Parse("2006-01", e.Withdrawal)
+	if err != nil {
+		return "", fmt.Errorf("list-three.xml: currency %s has invalid withdrawal date %q", e.Code, e.Withdrawal)
+	}
+
+	return t.AddDate(0, 1, 0).Format("2006-01-02"), nil
+}
+
+// validity applies validity dates and successor of the overlay,
+// valid_until of the overlay takes precedence over list three withdrawal date
+func validity(code string, c *currency, o *overlay) error {
+	for _, d := range []string{o.ValidFrom, o.ValidUntil} {
+		if _, err := time.Parse("2006-01-02", d); d != "" && err != nil {
+			return fmt.Errorf("overlay.json: currency %s has invalid date %q", code, d)
+		}
+	}
+
+	if o.ValidFrom != "" {
+		c.ValidFrom = o.ValidFrom
+	}
+	if o.ValidUntil != "" {
+		c.ValidUntil = o.ValidUntil
+	}
+
+	if (o.Successor == "") != (o.Rate == "") {
+		return fmt.Errorf("overlay.json: currency %s must have both successor and rate", code)
+	}
+	if o.Rate != "" {
+		if r, err := strconv.ParseFloat(o.Rate, 64); err != nil || r <= 0 {
+			return fmt.Errorf("overlay.json: currency %s has invalid rate %q", code, o.Rate)
+		}
+	}
+	if o.Successor != "" && c.ValidUntil == "" {
+		return fmt.Errorf("overlay.json: currency %s has successor but no withdrawal date", code)
+	}
+	c.Successor, c.Rate = o.Successor, o.Rate
+
+	return nil
+}
+
+func readXML(path string, v interface{}) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if err := xml.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%s: %v", filepath.Base(path), err)
+	}
+
+	return nil
+}
+
+func render(cs []*currency) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gencurrency from ISO 4217 lists; DO NOT EDIT.\n\n")
+	buf.WriteString("package money\n\n")
+	buf.WriteString("import \"github.com/shopspring/decimal\"\n\n")
+	buf.WriteString("// currencies represents a collection of built-in currencies,\n")
+	buf.WriteString("// it is copied into every Registry and must not be modified\n")
+	buf.WriteString("var currencies = map[string]*Currency{\n")
+	for _, c := range cs {
+		fmt.Fprintf(&buf, "\t%q: {Decimal: %+q, Thousand: %+q, Code: %q, Fraction: %d, Grapheme: %+q, Template: %+q, Name: %+q",
+			c.Code, c.Decimal, c.Thousand, c.Code, c.Fraction, c.Grapheme, c.Template, c.Name)
+		if c.Numeric != "" {
+			fmt.Fprintf(&buf, ", NumericCode: %q", c.Numeric)
+		}
+		if c.Historic {
+			buf.WriteString(", Historic: true")
+		}
+		if c.CashIncrement != 0 {
+			fmt.Fprintf(&buf, ", CashIncrement: %d", c.CashIncrement)
+		}
+		if c.ValidFrom != "" {
+			fmt.Fprintf(&buf, ", ValidFrom: %s", dateLiteral(c.ValidFrom))
+		}
+		if c.ValidUntil != "" {
+			fmt.Fprintf(&buf, ", ValidUntil: %s", dateLiteral(c.ValidUntil))
+		}
+		if c.Successor != "" {
+			fmt.Fprintf(&buf, ", Successor: &Redenomination{Code: %q, Rate: decimal.RequireFromString(%q)}", c.Successor, c.Rate)
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
+
+// dateLiteral returns Go expression of the date in YYYY-MM-DD format
+func dateLiteral(d string) string {
+	t, _ := time.Parse("2006-01-02", d)
+
+	return fmt.Sprintf("date(%d, %d, %d)", t.Year(), t.Month(), t.Day())
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+// TestGenerate_Drift fails when currency_data.go differs from the ISO 4217 data files,
+// run "go generate" in the repository root to update it
+func TestGenerate_Drift(t *testing.T) {
+	expected, err := generate("data")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := ioutil.ReadFile("../../currency_data.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(expected) != string(actual) {
+		t.Error("currency_data.go is out of date, run go generate")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	cs, err := load("data")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	byCode := map[string]*currency{}
+	for _, c := range cs {
+		byCode[c.Code] = c
+	}
+
+	tcs := []currency{
+		{Code: "ISK", Numeric: "352", Name: "Iceland Krona", Fraction: 0, Grapheme: "kr", Template: "$1", Decimal: ".", Thousand: ","},
+		{Code: "XAU", Numeric: "959", Name: "Gold", Fraction: 0, Grapheme: "XAU", Template: "1 $", Decimal: ".", Thousand: ","},
+		{Code: "LTL", Numeric: "440", Name: "Lithuanian Litas", Fraction: 2, Grapheme: "Lt", Template: "$1", Decimal: ".", Thousand: ",", Historic: true,
+			ValidUntil: "2015-01-01", Successor: "EUR", Rate: "3.4528"},
+		{Code: "TRY", Numeric: "949", Name: "Turkish Lira", Fraction: 2, Grapheme: "\u20ba", Template: "$1", Decimal: ".", Thousand: ",", ValidFrom: "2005-01-01"},
+		{Code: "CHF", Numeric: "756", Name: "Swiss Franc", Fraction: 2, Grapheme: "CHF", Template: "$ 1", Decimal: ".", Thousand: ",", CashIncrement: 5},
+		{Code: "JEP", Name: "Jersey Pound", Fraction: 2, Grapheme: "£", Template: "$1", Decimal: ".", Thousand: ","},
+	}
+
+	for _, tc := range tcs {
+		c, ok := byCode[tc.Code]
+		if !ok {
+			t.Errorf("Expected currency %s to be generated", tc.Code)
+			continue
+		}
+
+		if *c != tc {
+			t.Errorf("Expected %+v got %+v", tc, *c)
+		}
+	}
+
+	// historic currencies which are not in the overlay are skipped
+	if _, ok := byCode["DEM"]; ok {
+		t.Error("Unexpected currency DEM")
+	}
+}
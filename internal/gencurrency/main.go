@@ -0,0 +1,37 @@
+// Command gencurrency generates currency_data.go with the built-in currencies list.
+//
+// Currencies are read from ISO 4217 list one (current currencies) and list three
+// (historic denominations) XML files published by the ISO 4217 maintenance agency.
+// Graphemes and templates, which are not part of ISO 4217, come from overlay.json.
+// Historic currencies are included only when present in the overlay, which also
+// provides their minor units. Overlay entries absent from both lists are local
+// currencies like GGP and must have name and fraction.
+//
+// Historic currencies are valid until the month following their list three withdrawal
+// date. The overlay may override validity dates and sets the successor currency
+// with the official redenomination rate, e.g. 1000000 TRL for 1 TRY.
+// Cash increments in minor units, e.g. 5 for CHF coins of 0.05, come from the overlay too.
+//
+// Run "go generate" in the repository root after updating files in the data directory.
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+)
+
+func main() {
+	dir := flag.String("data", "internal/gencurrency/data", "directory with list-one.xml, list-three.xml and overlay.json")
+	output := flag.String("o", "currency_data.go", "output file")
+	flag.Parse()
+
+	data, err := generate(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(*output, data, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,239 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/shopspring/decimal"
+)
+
+// AmountEncoding selects how amount is written to JSON
+type AmountEncoding int
+
+const (
+	// AmountDecimal writes amount as decimal.Decimal does, a string like "12.3" by default
+	AmountDecimal AmountEncoding = iota
+	// AmountString writes amount as a string with currency Fraction digits, e.g. "12.30"
+	AmountString
+	// AmountNumber writes amount as a number with currency Fraction digits, e.g. 12.30
+	AmountNumber
+	// AmountMinorUnits writes amount as an integer number of minor units, e.g. 1230.
+	// This encoding, AmountString and AmountNumber return ErrRoundingNecessary
+	// if amount has more fraction digits than currency Fraction
+	AmountMinorUnits
+)
+
+// JSONLayout describes how Money is written to and read from JSON.
+// Zero JSONLayout is the default {"amount": "12.3", "currency": "EUR"} layout
+type JSONLayout struct {
+	// Amount selects amount encoding
+	Amount AmountEncoding
+	// AmountField is the name of amount field, "amount" if empty
+	AmountField string
+	// CurrencyField is the name of currency code field, "currency" if empty
+	CurrencyField string
+	// DisplayField adds the result of Display under the given name if set,
+	// the field is ignored when reading
+	DisplayField string
+}
+
+var (
+	// DefaultJSON is the default layout: {"amount": "12.3", "currency": "EUR"}
+	DefaultJSON = JSONLayout{}
+	// StringJSON writes amount as a string with all fraction digits: {"amount": "12.30", "currency": "EUR"}
+	StringJSON = JSONLayout{Amount: AmountString}
+	// MinorUnitsJSON writes amount in minor units: {"value": 1230, "currency": "EUR"}
+	MinorUnitsJSON = JSONLayout{Amount: AmountMinorUnits, AmountField: "value"}
+	// DisplayJSON adds formatted amount: {"amount": "12.30", "currency": "EUR", "display": "€12.30"}
+	DisplayJSON = JSONLayout{Amount: AmountString, DisplayField: "display"}
+)
+
+var jsonLayout = struct {
+	sync.RWMutex
+	layout JSONLayout
+}{}
+
+// SetJSONLayout sets layout used by Money MarshalJSON and UnmarshalJSON
+func SetJSONLayout(l JSONLayout) {
+	jsonLayout.Lock()
+	jsonLayout.layout = l
+	jsonLayout.Unlock()
+}
+
+// GetJSONLayout returns layout used by Money MarshalJSON and UnmarshalJSON
+func GetJSONLayout() JSONLayout {
+	jsonLayout.RLock()
+	defer jsonLayout.RUnlock()
+
+	return jsonLayout.layout
+}
+
+// JSON wraps Money to marshal and unmarshal it with the given layout instead of the global one:
+//
+//	json.Marshal(money.JSON{Money: price, Layout: money.MinorUnitsJSON})
+type JSON struct {
+	Money  *Money
+	Layout JSONLayout
+}
+
+// MarshalJSON implements json.Marshaler, nil Money is written as null
+func (j JSON) MarshalJSON() ([]byte, error) {
+	if j.Money == nil {
+		return []byte("null"), nil
+	}
+
+	return j.Layout.Marshal(j.Money)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, Money is allocated if nil
+func (j *JSON) UnmarshalJSON(data []byte) error {
+	if j.Money == nil {
+		j.Money = &Money{}
+	}
+
+	return j.Layout.Unmarshal(data, j.Money)
+}
+
+// Marshal returns JSON encoding of Money using the layout
+func (l JSONLayout) Marshal(m *Money) ([]byte, error) {
+	var code, display string
+	fraction := 0
+	if m.currency != nil {
+		code, display, fraction = m.currency.Code, m.Display(), m.currency.Fraction
+	}
+
+	var amount []byte
+	var err error
+	switch l.Amount {
+	case AmountDecimal:
+		amount, err = json.Marshal(m.amount)
+	case AmountString:
+		var s string
+		if s, err = fixed(m.amount, fraction); err == nil {
+			amount, err = json.Marshal(s)
+		}
+	case AmountNumber:
+		var s string
+		if s, err = fixed(m.amount, fraction); err == nil {
+			amount = []byte(s)
+		}
+	case AmountMinorUnits:
+		var units int64
+		if units, err = minorUnits(m.amount, fraction, code); err == nil {
+			amount, err = json.Marshal(units)
+		}
+	default:
+		err = fmt.Errorf("money: unknown amount encoding %d", l.Amount)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	writeJSONField(&buf, l.amountField(), amount)
+	buf.WriteByte(',')
+	writeJSONField(&buf, l.currencyField(), jsonString(code))
+	if l.DisplayField != "" {
+		buf.WriteByte(',')
+		writeJSONField(&buf, l.DisplayField, jsonString(display))
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal reads Money from JSON using the layout and the default registry.
+// Amount is rounded by currency Fraction
+func (l JSONLayout) Unmarshal(data []byte, m *Money) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	var code string
+	if raw, ok := fields[l.currencyField()]; ok {
+		if err := json.Unmarshal(raw, &code); err != nil {
+			return err
+		}
+	}
+
+	c, err := DefaultRegistry.resolve(code)
+	if err != nil {
+		return err
+	}
+
+	amount, err := l.amount(fields[l.amountField()], c)
+	if err != nil {
+		return err
+	}
+
+	mm, err := DefaultRegistry.newMoney(amount, c, 0)
+	if err != nil {
+		return err
+	}
+	*m = *mm
+
+	return nil
+}
+
+// fixed returns amount with exactly fraction digits,
+// ErrRoundingNecessary is returned if amount has more of them
+func fixed(amount decimal.Decimal, fraction int) (string, error) {
+	if !amount.Equal(amount.Truncate(int32(fraction))) {
+		return "", ErrRoundingNecessary
+	}
+
+	return amount.StringFixed(int32(fraction)), nil
+}
+
+// amount reads amount field of the layout, missing field is zero
+func (l JSONLayout) amount(raw json.RawMessage, c *Currency) (decimal.Decimal, error) {
+	if raw == nil {
+		return decimal.Zero, nil
+	}
+
+	if l.Amount == AmountMinorUnits {
+		var units int64
+		if err := json.Unmarshal(raw, &units); err != nil {
+			return decimal.Zero, err
+		}
+
+		return decimal.New(units, -int32(c.Fraction)), nil
+	}
+
+	var amount decimal.Decimal
+	err := amount.UnmarshalJSON(raw)
+
+	return amount, err
+}
+
+func (l JSONLayout) amountField() string {
+	if l.AmountField == "" {
+		return "amount"
+	}
+
+	return l.AmountField
+}
+
+func (l JSONLayout) currencyField() string {
+	if l.CurrencyField == "" {
+		return "currency"
+	}
+
+	return l.CurrencyField
+}
+
+func writeJSONField(buf *bytes.Buffer, name string, value []byte) {
+	buf.Write(jsonString(name))
+	buf.WriteByte(':')
+	buf.Write(value)
+}
+
+func jsonString(s string) []byte {
+	b, _ := json.Marshal(s)
+
+	return b
+}
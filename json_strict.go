@@ -0,0 +1,334 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// JSONError describes invalid Money found by strict decoding.
+// Path names the invalid value, e.g. "$.items[2].price.amount"
+type JSONError struct {
+	Path string
+	Msg  string
+	// Err is the underlying error, e.g. *UnknownCurrencyError, if any
+	Err error
+}
+
+func (e *JSONError) Error() string {
+	return fmt.Sprintf("money: %s: %s", e.Path, e.Msg)
+}
+
+// Unwrap returns the underlying error
+func (e *JSONError) Unwrap() error {
+	return e.Err
+}
+
+// UnmarshalStrict parses JSON document into v like json.Unmarshal, but all Money values
+// are validated first. Amount and currency fields must be present, currency must be
+// a registered code of the default registry, amount must not have more fraction digits
+// than currency Fraction and no other fields are allowed. Money values are found by
+// walking v the way encoding/json does, *JSONError is returned for the first invalid one
+func UnmarshalStrict(data []byte, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return json.Unmarshal(data, v)
+	}
+
+	if !json.Valid(data) {
+		return json.Unmarshal(data, v)
+	}
+
+	if err := validateJSON(data, rv, "$"); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// StrictDecoder reads JSON values from a stream and decodes them like UnmarshalStrict
+type StrictDecoder struct {
+	dec *json.Decoder
+}
+
+// NewStrictDecoder returns StrictDecoder reading from r
+func NewStrictDecoder(r io.Reader) *StrictDecoder {
+	return &StrictDecoder{dec: json.NewDecoder(r)}
+}
+
+// Decode reads the next JSON value and stores it in v like UnmarshalStrict
+func (d *StrictDecoder) Decode(v interface{}) error {
+	var raw json.RawMessage
+	if err := d.dec.Decode(&raw); err != nil {
+		return err
+	}
+
+	return UnmarshalStrict(raw, v)
+}
+
+var (
+	moneyType     = reflect.TypeOf(Money{})
+	jsonType      = reflect.TypeOf(JSON{})
+	unmarshalType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// validateJSON walks JSON value along with Go value it is decoded into and validates Money values
+func validateJSON(data []byte, v reflect.Value, path string) error {
+	null := bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+
+	switch v.Type() {
+	case moneyType:
+		if null {
+			return &JSONError{Path: path, Msg: "money must not be null"}
+		}
+		return validateMoneyJSON(data, GetJSONLayout(), path)
+	case jsonType:
+		if null {
+			return nil
+		}
+		var l JSONLayout
+		if v.CanInterface() {
+			l = v.Interface().(JSON).Layout
+		}
+		return validateMoneyJSON(data, l, path)
+	}
+
+	if null {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return validateJSON(data, reflect.New(v.Type().Elem()).Elem(), path)
+		}
+		return validateJSON(data, v.Elem(), path)
+	case reflect.Interface:
+		if v.IsNil() || v.Elem().Kind() != reflect.Ptr {
+			return nil
+		}
+		return validateJSON(data, v.Elem(), path)
+	}
+
+	if reflect.PtrTo(v.Type()).Implements(unmarshalType) {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		members, ok := jsonObject(data)
+		if !ok {
+			return nil
+		}
+		for _, m := range members {
+			if f, ok := jsonField(v, m.name); ok {
+				if err := validateJSON(m.value, f, path+"."+m.name); err != nil {
+					return err
+				}
+			}
+		}
+	case reflect.Map:
+		members, ok := jsonObject(data)
+		if !ok || v.Type().Key().Kind() != reflect.String {
+			return nil
+		}
+		elem := reflect.New(v.Type().Elem()).Elem()
+		for _, m := range members {
+			if err := validateJSON(m.value, elem, path+"."+m.name); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		var items []json.RawMessage
+		if json.Unmarshal(data, &items) != nil {
+			return nil
+		}
+		for i, item := range items {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			if i < v.Len() {
+				elem = v.Index(i)
+			}
+			if err := validateJSON(item, elem, path+"["+strconv.Itoa(i)+"]"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateMoneyJSON validates JSON object of Money written with the layout
+func validateMoneyJSON(data []byte, l JSONLayout, path string) error {
+	members, ok := jsonObject(data)
+	if !ok {
+		return &JSONError{Path: path, Msg: "money must be an object"}
+	}
+
+	amountField, currencyField := l.amountField(), l.currencyField()
+	fields := map[string]json.RawMessage{}
+	for _, m := range members {
+		if m.name != amountField && m.name != currencyField && m.name != l.DisplayField {
+			return &JSONError{Path: path + "." + m.name, Msg: "unexpected field"}
+		}
+		fields[m.name] = m.value
+	}
+
+	rawCode, ok := fields[currencyField]
+	if !ok {
+		return &JSONError{Path: path + "." + currencyField, Msg: "missing field"}
+	}
+	var code string
+	if err := json.Unmarshal(rawCode, &code); err != nil {
+		return &JSONError{Path: path + "." + currencyField, Msg: "currency must be a string"}
+	}
+	c, err := DefaultRegistry.Lookup(code)
+	if err != nil {
+		return &JSONError{Path: path + "." + currencyField, Msg: strings.TrimPrefix(err.Error(), "money: "), Err: err}
+	}
+
+	rawAmount, ok := fields[amountField]
+	if !ok {
+		return &JSONError{Path: path + "." + amountField, Msg: "missing field"}
+	}
+
+	amount, err := strictAmount(rawAmount, l.Amount, c)
+	if err != nil {
+		return &JSONError{Path: path + "." + amountField, Msg: err.Error()}
+	}
+	if !amount.Equal(amount.Round(int32(c.Fraction))) {
+		return &JSONError{Path: path + "." + amountField,
+			Msg: fmt.Sprintf("amount %s has more than %d fraction digits of %s", amount, c.Fraction, c.Code)}
+	}
+
+	return nil
+}
+
+// strictAmount reads amount which must be encoded as the layout writes it
+func strictAmount(raw json.RawMessage, encoding AmountEncoding, c *Currency) (decimal.Decimal, error) {
+	quoted := len(raw) > 0 && raw[0] == '"'
+
+	switch encoding {
+	case AmountMinorUnits:
+		var units int64
+		if quoted || json.Unmarshal(raw, &units) != nil {
+			return decimal.Zero, fmt.Errorf("amount must be an integer number of minor units")
+		}
+		return decimal.New(units, -int32(c.Fraction)), nil
+	case AmountString:
+		if !quoted {
+			return decimal.Zero, fmt.Errorf("amount must be a string")
+		}
+	case AmountNumber:
+		if quoted {
+			return decimal.Zero, fmt.Errorf("amount must be a number")
+		}
+	}
+
+	s := string(raw)
+	if quoted {
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return decimal.Zero, err
+		}
+	}
+
+	amount, err := decimal.NewFromString(s)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid amount %s", raw)
+	}
+
+	return amount, nil
+}
+
+// jsonField returns struct field the JSON object key is decoded into,
+// exact field name match is preferred to case insensitive one like encoding/json does
+func jsonField(v reflect.Value, name string) (reflect.Value, bool) {
+	var fold reflect.Value
+	found := false
+
+	var walk func(v reflect.Value) bool
+	walk = func(v reflect.Value) bool {
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			tag := sf.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+
+			fieldName := strings.Split(tag, ",")[0]
+			if sf.Anonymous && fieldName == "" {
+				ft := sf.Type
+				if ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				if ft.Kind() == reflect.Struct {
+					f := v.Field(i)
+					if f.Kind() == reflect.Ptr {
+						if f.IsNil() {
+							f = reflect.New(ft)
+						}
+						f = f.Elem()
+					}
+					if walk(f) {
+						return true
+					}
+					continue
+				}
+			}
+			if sf.PkgPath != "" {
+				continue
+			}
+			if fieldName == "" {
+				fieldName = sf.Name
+			}
+
+			if fieldName == name {
+				fold, found = v.Field(i), true
+				return true
+			}
+			if !found && strings.EqualFold(fieldName, name) {
+				fold, found = v.Field(i), true
+			}
+		}
+
+		return false
+	}
+	walk(v)
+
+	return fold, found
+}
+
+type jsonMember struct {
+	name  string
+	value json.RawMessage
+}
+
+// jsonObject returns members of JSON object in the document order
+func jsonObject(data []byte) ([]jsonMember, bool) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if t, err := dec.Token(); err != nil || t != json.Delim('{') {
+		return nil, false
+	}
+
+	var members []jsonMember
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return nil, false
+		}
+
+		m := jsonMember{name: t.(string)}
+		if err := dec.Decode(&m.value); err != nil {
+			return nil, false
+		}
+		members = append(members, m)
+	}
+
+	return members, true
+}
@@ -0,0 +1,105 @@
+package money_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/amanbolat/go-money"
+	"github.com/stretchr/testify/assert"
+)
+
+type strictItem struct {
+	Name  string       `json:"name"`
+	Price *money.Money `json:"price"`
+}
+
+type strictOrder struct {
+	Items    []strictItem            `json:"items"`
+	Total    money.Money             `json:"total"`
+	Fees     map[string]*money.Money `json:"fees"`
+	Discount money.JSON              `json:"discount"`
+}
+
+func TestUnmarshalStrict(t *testing.T) {
+	data := `{
+		"items": [{"name": "a", "price": {"amount": "1.50", "currency": "USD"}}, {"name": "b", "price": null}],
+		"total": {"amount": "1.5", "currency": "USD"},
+		"fees": {"card": {"amount": "0.10", "currency": "USD"}},
+		"discount": {"value": 10, "currency": "USD"}
+	}`
+
+	order := strictOrder{Discount: money.JSON{Layout: money.MinorUnitsJSON}}
+	if assert.NoError(t, money.UnmarshalStrict([]byte(data), &order)) {
+		assert.Equal(t, "1.5", order.Items[0].Price.Amount().String())
+		assert.Nil(t, order.Items[1].Price)
+		assert.Equal(t, "0.1", order.Fees["card"].Amount().String())
+		assert.Equal(t, "0.1", order.Discount.Money.Amount().String())
+	}
+}
+
+func TestUnmarshalStrict_Errors(t *testing.T) {
+	tcs := []struct {
+		data string
+		path string
+		msg  string
+	}{
+		{`{"total": {"amount": "1.5"}}`, "$.total.currency", "missing field"},
+		{`{"total": {"currency": "USD"}}`, "$.total.amount", "missing field"},
+		{`{"total": {"amount": "1.5", "currency": "UDS"}}`, "$.total.currency", `unknown currency "UDS"`},
+		{`{"total": {"amount": "1.5", "currency": "usd"}}`, "$.total.currency", `invalid currency code "usd", expected three upper case letters`},
+		{`{"total": {"amount": "1.5", "currency": 840}}`, "$.total.currency", "currency must be a string"},
+		{`{"total": {"amount": "12.345", "currency": "USD"}}`, "$.total.amount", "amount 12.345 has more than 2 fraction digits of USD"},
+		{`{"total": {"amount": "abc", "currency": "USD"}}`, "$.total.amount", `invalid amount "abc"`},
+		{`{"total": {"amount": "1", "currency": "USD", "note": "x"}}`, "$.total.note", "unexpected field"},
+		{`{"total": null}`, "$.total", "money must not be null"},
+		{`{"total": "1 USD"}`, "$.total", "money must be an object"},
+		{`{"items": [{}, {"price": {"amount": "1", "currency": "XXY"}}]}`, "$.items[1].price.currency", `unknown currency "XXY"`},
+		{`{"fees": {"card": {"amount": 1.001, "currency": "EUR"}}}`, "$.fees.card.amount", "amount 1.001 has more than 2 fraction digits of EUR"},
+		{`{"discount": {"value": "10", "currency": "USD"}}`, "$.discount.value", "amount must be an integer number of minor units"},
+		{`{"discount": {"amount": 10, "currency": "USD"}}`, "$.discount.amount", "unexpected field"},
+	}
+
+	for _, tc := range tcs {
+		order := strictOrder{Discount: money.JSON{Layout: money.MinorUnitsJSON}}
+		err := money.UnmarshalStrict([]byte(tc.data), &order)
+		if assert.IsType(t, &money.JSONError{}, err, tc.data) {
+			assert.Equal(t, tc.path, err.(*money.JSONError).Path, tc.data)
+			assert.Equal(t, tc.msg, err.(*money.JSONError).Msg, tc.data)
+		}
+	}
+
+	err := money.UnmarshalStrict([]byte(`{"amount": "1", "currency": "UDS"}`), &money.Money{})
+	if assert.IsType(t, &money.JSONError{}, err) {
+		assert.Equal(t, "money: $.currency: unknown currency \"UDS\"", err.Error())
+		assert.Equal(t, &money.UnknownCurrencyError{Code: "UDS"}, err.(*money.JSONError).Err)
+	}
+}
+
+func TestUnmarshalStrict_FieldMatching(t *testing.T) {
+	type Embedded struct {
+		Price money.Money
+	}
+	v := struct {
+		Embedded
+		Ignored money.Money `json:"-"`
+	}{}
+
+	err := money.UnmarshalStrict([]byte(`{"price": {"amount": "1", "currency": "UDS"}}`), &v)
+	if assert.IsType(t, &money.JSONError{}, err) {
+		assert.Equal(t, "$.price.currency", err.(*money.JSONError).Path)
+	}
+
+	assert.NoError(t, money.UnmarshalStrict([]byte(`{"Ignored": {"amount": "1.001"}}`), &v))
+
+	var syntax *json.SyntaxError
+	assert.IsType(t, syntax, money.UnmarshalStrict([]byte(`{"price": `), &v))
+}
+
+func TestStrictDecoder(t *testing.T) {
+	dec := money.NewStrictDecoder(strings.NewReader(`{"amount": "1", "currency": "EUR"} {"amount": "1.001", "currency": "EUR"}`))
+
+	var m money.Money
+	assert.NoError(t, dec.Decode(&m))
+	assert.IsType(t, &money.JSONError{}, dec.Decode(&m))
+}
@@ -0,0 +1,95 @@
+package money_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/amanbolat/go-money"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONLayout_Marshal(t *testing.T) {
+	m := money.New(1230, "EUR")
+
+	tcs := []struct {
+		layout   money.JSONLayout
+		expected string
+	}{
+		{money.DefaultJSON, `{"amount":"12.3","currency":"EUR"}`},
+		{money.StringJSON, `{"amount":"12.30","currency":"EUR"}`},
+		{money.JSONLayout{Amount: money.AmountNumber}, `{"amount":12.30,"currency":"EUR"}`},
+		{money.MinorUnitsJSON, `{"value":1230,"currency":"EUR"}`},
+		{money.DisplayJSON, `{"amount":"12.30","currency":"EUR","display":"€12.30"}`},
+		{money.JSONLayout{Amount: money.AmountString, AmountField: "sum", CurrencyField: "ccy"}, `{"sum":"12.30","ccy":"EUR"}`},
+	}
+
+	for _, tc := range tcs {
+		b, err := json.Marshal(money.JSON{Money: m, Layout: tc.layout})
+		if assert.NoError(t, err) {
+			assert.Equal(t, tc.expected, string(b))
+		}
+
+		var j money.JSON
+		j.Layout = tc.layout
+		if assert.NoError(t, json.Unmarshal([]byte(tc.expected), &j)) {
+			assert.Equal(t, "12.3", j.Money.Amount().String())
+			assert.Equal(t, "EUR", j.Money.Currency().Code)
+		}
+	}
+
+	_, err := money.MinorUnitsJSON.Marshal(m.Divide(7))
+	assert.Equal(t, money.ErrRoundingNecessary, err)
+}
+
+func TestJSONLayout_MarshalExtraPrecision(t *testing.T) {
+	m := money.New(100, "USD").Divide(8)
+
+	b, err := money.DefaultJSON.Marshal(m)
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"amount":"0.125","currency":"USD"}`, string(b))
+	}
+
+	layouts := []money.JSONLayout{money.StringJSON, {Amount: money.AmountNumber}, money.MinorUnitsJSON, money.DisplayJSON}
+	for _, l := range layouts {
+		_, err := l.Marshal(m)
+		assert.Equal(t, money.ErrRoundingNecessary, err, "%+v", l)
+	}
+
+	b, err = money.StringJSON.Marshal(money.NewFromDecimal(decimal.RequireFromString("12.500"), "EUR"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"amount":"12.50","currency":"EUR"}`, string(b))
+	}
+}
+
+func TestJSON_Nil(t *testing.T) {
+	b, err := json.Marshal(money.JSON{})
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(b))
+
+	v := struct {
+		Price money.JSON `json:"price"`
+	}{Price: money.JSON{Layout: money.MinorUnitsJSON}}
+	if assert.NoError(t, json.Unmarshal([]byte(`{"price":{"value":500,"currency":"JPY"}}`), &v)) {
+		assert.Equal(t, "500", v.Price.Money.Amount().String())
+	}
+}
+
+func TestSetJSONLayout(t *testing.T) {
+	money.SetJSONLayout(money.MinorUnitsJSON)
+	defer money.SetJSONLayout(money.DefaultJSON)
+
+	assert.Equal(t, money.MinorUnitsJSON, money.GetJSONLayout())
+
+	b, err := json.Marshal(money.New(1230, "EUR"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"value":1230,"currency":"EUR"}`, string(b))
+	}
+
+	m := &money.Money{}
+	if assert.NoError(t, json.Unmarshal([]byte(`{"value":-5,"currency":"USD"}`), m)) {
+		assert.Equal(t, "-0.05", m.Amount().String())
+	}
+
+	assert.Error(t, json.Unmarshal([]byte(`{"value":"5","currency":"USD"}`), m))
+}
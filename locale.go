@@ -0,0 +1,132 @@
+package money
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/amanbolat/go-money/cldr"
+)
+
+// Locale represents number formatting rules of a language and region
+type Locale struct {
+	Tag            string `json:"tag"`
+	Decimal        string `json:"decimal"`
+	Thousand       string `json:"thousand"`
+	Minus          string `json:"minus"`
+	Group          int    `json:"group"`
+	SecondaryGroup int    `json:"secondary_group"`
+	Template       string `json:"template"`
+
+	data *cldr.Locale
+}
+
+// locales represents a collection of custom locale formatting rules,
+// they take precedence over the bundled CLDR data. localesMu guards it
+// as locales can be added while other goroutines format Money
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]*Locale{}
+)
+
+// AddLocale lets you insert or update locale in locales list
+func AddLocale(Tag, Decimal, Thousand, Template string, Group, SecondaryGroup int) *Locale {
+	tag := cldr.Normalize(Tag)
+	l := &Locale{
+		Tag:            tag,
+		Decimal:        Decimal,
+		Thousand:       Thousand,
+		Minus:          "-",
+		Group:          Group,
+		SecondaryGroup: SecondaryGroup,
+		Template:       Template,
+	}
+
+	localesMu.Lock()
+	locales[tag] = l
+	localesMu.Unlock()
+
+	return l
+}
+
+// GetLocale returns the locale given the tag, e.g. "de-DE" or "de_DE".
+// Locales added by AddLocale are returned first, then bundled CLDR data is used
+func GetLocale(tag string) *Locale {
+	localesMu.RLock()
+	l, ok := locales[cldr.Normalize(tag)]
+	localesMu.RUnlock()
+	if ok {
+		return l
+	}
+
+	data, ok := cldr.Lookup(tag)
+	if !ok {
+		return nil
+	}
+
+	return newLocaleFromCLDR(data)
+}
+
+func newLocaleFromCLDR(data *cldr.Locale) *Locale {
+	return &Locale{
+		Tag:            data.Tag,
+		Decimal:        data.Decimal,
+		Thousand:       data.Group,
+		Minus:          data.Minus,
+		Group:          data.PrimaryGroup,
+		SecondaryGroup: data.SecondaryGroup,
+		Template:       templateFromPattern(data.CurrencyPattern),
+		data:           data,
+	}
+}
+
+// Formatter returns Formatter for the given currency using locale rules
+// and the standard currency symbol of the locale
+func (l *Locale) Formatter(c *Currency) *Formatter {
+	return l.formatter(c, false)
+}
+
+// NarrowFormatter returns Formatter for the given currency using locale rules
+// and the narrow currency symbol of the locale, e.g. "$" instead of "US$"
+func (l *Locale) NarrowFormatter(c *Currency) *Formatter {
+	return l.formatter(c, true)
+}
+
+func (l *Locale) formatter(c *Currency, narrow bool) *Formatter {
+	grapheme := c.Grapheme
+	if l.data != nil {
+		if s, ok := l.data.Symbols[c.Code]; ok {
+			grapheme = s.Standard
+			if narrow {
+				grapheme = s.Narrow
+			}
+		}
+	}
+
+	return &Formatter{
+		Fraction:       c.Fraction,
+		Decimal:        l.Decimal,
+		Thousand:       l.Thousand,
+		Minus:          l.Minus,
+		Group:          l.Group,
+		SecondaryGroup: l.SecondaryGroup,
+		Grapheme:       grapheme,
+		Template:       l.Template,
+	}
+}
+
+// templateFromPattern converts CLDR currency pattern into currency Template,
+// e.g. "#,##0.00 ¤" becomes "1 $". Non-breaking spaces are replaced by spaces
+func templateFromPattern(pattern string) string {
+	start := strings.IndexAny(pattern, "#0")
+	end := strings.LastIndexAny(pattern, "#0")
+	if start < 0 {
+		return "$1"
+	}
+
+	template := pattern[:start] + "1" + pattern[end+1:]
+	template = strings.Replace(template, "\u00a4", "$", 1)
+	template = strings.Replace(template, "\u00a0", " ", -1)
+	template = strings.Replace(template, "\u202f", " ", -1)
+
+	return template
+}
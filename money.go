@@ -3,8 +3,6 @@ package money
 import (
 	"errors"
 	"github.com/shopspring/decimal"
-	"strings"
-	"encoding/json"
 )
 
 // Money represents monetary value information, stores
@@ -12,61 +10,50 @@ import (
 type Money struct {
 	amount   decimal.Decimal
 	currency *Currency
+	rounding RoundingMode
 }
 
+// UnmarshalJSON implements json.Unmarshaler using layout set by SetJSONLayout
 func (m *Money) UnmarshalJSON(data []byte) error {
-	s := &struct {
-		Amount decimal.Decimal `json:"amount"`
-		Currency string `json:"currency"`
-	}{}
-	err := json.Unmarshal(data, s)
-	if err != nil {
-		return err
-	}
-
-	val :=  NewFromDecimal(s.Amount, s.Currency)
-	*m = *val
-
-	return nil
+	return GetJSONLayout().Unmarshal(data, m)
 }
 
+// MarshalJSON implements json.Marshaler using layout set by SetJSONLayout
 func (m Money) MarshalJSON() ([]byte, error) {
-	var currency string
-	if m.currency != nil {
-		currency = m.Currency().Code
-	}
-	s := &struct {
-		Amount decimal.Decimal `json:"amount"`
-		Currency string `json:"currency"`
-	}{
-		Amount: m.amount,
-		Currency: currency,
-	}
-
-	return json.Marshal(s)
+	return GetJSONLayout().Marshal(&m)
 }
 
-
-
 // New creates and returns new instance of Money
 // amount should be in cents for currency
 // Example: New(100, "EUR") = 1 EUR
 func New(amount int64, code string) *Money {
-	c := newCurrency(code).get()
-	return &Money{
-		amount:   decimal.New(amount, -int32(c.Fraction)),
-		currency: c,
-	}
+	return DefaultRegistry.New(amount, code)
 }
 
 // NewFromDecimal creates Money instance from decimal.Decimal
 // and rounds it by currency Fraction
 func NewFromDecimal(amount decimal.Decimal, code string) *Money {
-	c := newCurrency(code).get()
-	return &Money{
-		amount:   amount.Round(int32(c.Fraction)),
-		currency: c,
-	}
+	return DefaultRegistry.NewFromDecimal(amount, code)
+}
+
+// NewFromDecimalWithMode creates Money instance from decimal.Decimal
+// and rounds it by currency Fraction using the rounding mode
+func NewFromDecimalWithMode(amount decimal.Decimal, code string, mode RoundingMode) (*Money, error) {
+	return DefaultRegistry.NewFromDecimalWithMode(amount, code, mode)
+}
+
+// NewStrict creates and returns new instance of Money like New,
+// but returns error if currency is not present in the default registry
+// or code is not shaped as ISO 4217 code
+func NewStrict(amount int64, code string) (*Money, error) {
+	return DefaultRegistry.NewStrict(amount, code)
+}
+
+// NewFromDecimalStrict creates Money instance from decimal.Decimal like NewFromDecimal,
+// but returns error if currency is not present in the default registry
+// or code is not shaped as ISO 4217 code
+func NewFromDecimalStrict(amount decimal.Decimal, code string) (*Money, error) {
+	return DefaultRegistry.NewFromDecimalStrict(amount, code)
 }
 
 // Currency returns the currency used by Money
@@ -79,14 +66,28 @@ func (m *Money) Amount() decimal.Decimal {
 	return m.amount
 }
 
+// RoundingMode returns rounding mode used by operations without explicit mode.
+// It is currency Rounding if set, then rounding of the registry Money was created by, then HalfUp
+func (m *Money) RoundingMode() RoundingMode {
+	if m.currency != nil && m.currency.Rounding != 0 {
+		return m.currency.Rounding
+	}
+	if m.rounding != 0 {
+		return m.rounding
+	}
+
+	return HalfUp
+}
+
 // SameCurrency check if given Money is equals by currency
 func (m *Money) SameCurrency(om *Money) bool {
 	return m.currency.equals(om.currency)
 }
 
+// assertSameCurrency returns *CurrencyMismatchError with Index 1 if the other Money has different currency
 func (m *Money) assertSameCurrency(om *Money) error {
 	if !m.SameCurrency(om) {
-		return errors.New("currencies don't match")
+		return &CurrencyMismatchError{Index: 1, Expected: m.currency.Code, Actual: om.currency.Code}
 	}
 
 	return nil
@@ -154,15 +155,15 @@ func (m *Money) IsNegative() bool {
 
 // Absolute returns new Money struct from given Money using absolute monetary value
 func (m *Money) Absolute() *Money {
-	return &Money{amount: m.amount.Abs(), currency: m.currency}
+	return &Money{amount: m.amount.Abs(), currency: m.currency, rounding: m.rounding}
 }
 
 // Negative returns new Money struct from given Money using negative monetary value
 func (m *Money) Negative() *Money {
 	if m.IsNegative() {
-		return &Money{amount: m.amount, currency: m.currency}
+		return &Money{amount: m.amount, currency: m.currency, rounding: m.rounding}
 	}
-	return &Money{amount: m.amount.Neg(), currency: m.currency}
+	return &Money{amount: m.amount.Neg(), currency: m.currency, rounding: m.rounding}
 }
 
 // Add returns new Money struct with value representing sum of Self and Other Money
@@ -171,7 +172,7 @@ func (m *Money) Add(om *Money) (*Money, error) {
 		return nil, err
 	}
 
-	return &Money{amount: m.amount.Add(om.amount), currency: m.currency}, nil
+	return &Money{amount: m.amount.Add(om.amount), currency: m.currency, rounding: m.rounding}, nil
 }
 
 // Subtract returns new Money struct with value representing difference of Self and Other Money
@@ -180,23 +181,69 @@ func (m *Money) Subtract(om *Money) (*Money, error) {
 		return nil, err
 	}
 
-	return &Money{amount: m.amount.Sub(om.amount), currency: m.currency}, nil
+	return &Money{amount: m.amount.Sub(om.amount), currency: m.currency, rounding: m.rounding}, nil
 }
 
 // Multiply returns new Money struct with value representing Self multiplied value by multiplier
 func (m *Money) Multiply(mul int64) *Money {
-	return &Money{amount: m.amount.Mul(decimal.New(mul, 0)), currency: m.currency}
+	return &Money{amount: m.amount.Mul(decimal.New(mul, 0)), currency: m.currency, rounding: m.rounding}
+}
+
+// MultiplyWithMode returns new Money struct with value representing Self multiplied value by multiplier
+// rounded by currency Fraction using the rounding mode, zero mode stands for RoundingMode of Money
+func (m *Money) MultiplyWithMode(mul int64, mode RoundingMode) (*Money, error) {
+	return m.Multiply(mul).RoundWithMode(int32(m.currency.Fraction), mode)
 }
 
-// Divide returns new Money struct with value representing Self division value by given divider
+// Divide returns new Money struct with value representing Self division value by given divider.
+// Value is rounded to decimal.DivisionPrecision digits using RoundingMode of Money,
+// HalfUp is used if the mode is Unnecessary. It panics if divider is zero
 func (m *Money) Divide(div int64) *Money {
-	return &Money{amount: m.amount.Div(decimal.New(div, 0)), currency: m.currency}
+	amount, err := divide(m.amount, decimal.New(div, 0), int32(decimal.DivisionPrecision), lenient(m.RoundingMode()))
+	if err != nil {
+		panic(err)
+	}
+
+	return &Money{amount: amount, currency: m.currency, rounding: m.rounding}
 }
 
-// Round returns new Money struct with value rounded to nearest zero
+// DivideWithMode returns new Money struct with value representing Self division value by given divider
+// rounded by currency Fraction using the rounding mode, zero mode stands for RoundingMode of Money
+func (m *Money) DivideWithMode(div int64, mode RoundingMode) (*Money, error) {
+	if mode == 0 {
+		mode = m.RoundingMode()
+	}
+
+	amount, err := divide(m.amount, decimal.New(div, 0), int32(m.currency.Fraction), mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: amount, currency: m.currency, rounding: m.rounding}, nil
+}
+
+// Round returns new Money struct with value rounded to scale decimal places using RoundingMode of Money,
+// HalfUp is used if the mode is Unnecessary. Use RoundWithMode to get ErrRoundingNecessary instead
 func (m *Money) Round(scale int32) *Money {
-	//return &Money{amount: m.amount.Round(int32(c.Fraction)), currency: m.currency}
-	return &Money{amount:m.amount.Round(scale), currency: m.currency}
+	r, _ := m.RoundWithMode(scale, lenient(m.RoundingMode()))
+
+	return r
+}
+
+// RoundWithMode returns new Money struct with value rounded to scale decimal places using the rounding mode,
+// zero mode stands for RoundingMode of Money. ErrRoundingNecessary is returned for Unnecessary mode
+// if value must be rounded
+func (m *Money) RoundWithMode(scale int32, mode RoundingMode) (*Money, error) {
+	if mode == 0 {
+		mode = m.RoundingMode()
+	}
+
+	amount, err := round(m.amount, scale, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: amount, currency: m.currency, rounding: m.rounding}, nil
 }
 
 // Split returns slice of Money structs with split Self value in given number.
@@ -216,9 +263,9 @@ func (m *Money) Split(n int) ([]*Money, error) {
 	for i := 0; i < n; i++ {
 		if !rem.Equal(decimal.Zero) {
 			rem = rem.Sub(remUnit)
-			arr[i] = &Money{amount: quo.Add(remUnit), currency: m.currency}
+			arr[i] = &Money{amount: quo.Add(remUnit), currency: m.currency, rounding: m.rounding}
 		} else {
-			arr[i] = &Money{amount: quo, currency: m.currency}
+			arr[i] = &Money{amount: quo, currency: m.currency, rounding: m.rounding}
 		}
 	}
 
@@ -236,6 +283,7 @@ func (m *Money) Split(n int) ([]*Money, error) {
 // Allocate returns slice of Money structs with split Self value in given ratios.
 // It lets split money by given ratios without losing pennies and as Split operations distributes
 // leftover pennies amongst the parties with round-robin principle.
+// Parties are rounded using RoundingMode of Money, HalfUp is used if the mode is Unnecessary
 func (m *Money) Allocate(ratios ...int) ([]*Money, error) {
 	if len(ratios) == 0 {
 		return nil, errors.New("no ratios specified")
@@ -249,10 +297,16 @@ func (m *Money) Allocate(ratios ...int) ([]*Money, error) {
 
 	var total decimal.Decimal
 	var resultMoneys []*Money
+	mode := lenient(m.RoundingMode())
 	for _, ratio := range ratios {
+		amount, err := divide(m.amount.Mul(decimal.New(int64(ratio), 0)), decimal.New(int64(sum), 0), int32(m.currency.Fraction), mode)
+		if err != nil {
+			return nil, err
+		}
 		party := &Money{
-			amount:  m.amount.Mul(decimal.New(int64(ratio), 0)).DivRound(decimal.New(int64(sum), 0), int32(m.currency.Fraction)),
+			amount:   amount,
 			currency: m.currency,
+			rounding: m.rounding,
 		}
 
 		resultMoneys = append(resultMoneys, party)
@@ -280,16 +334,16 @@ func (m *Money) Allocate(ratios ...int) ([]*Money, error) {
 
 // Display lets represent Money struct as string in given Currency value
 func (m *Money) Display() string {
-	c := m.currency.get()
-
-	str := m.amount.Abs().StringFixed(int32(c.Fraction))
-
-	str = strings.Replace(c.Template, "1", str, 1)
-	str = strings.Replace(str, "$", c.Grapheme, 1)
+	return m.currency.Formatter().Format(m.amount)
+}
 
-	if m.IsNegative() {
-		str = "-" + str
+// FormatLocale lets represent Money struct as string using formatting rules
+// of the given locale, e.g. "de-DE". Currency formatting is used if locale is unknown
+func (m *Money) FormatLocale(tag string) string {
+	l := GetLocale(tag)
+	if l == nil {
+		return m.Display()
 	}
 
-	return str
+	return l.Formatter(m.currency).Format(m.amount)
 }
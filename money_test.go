@@ -22,6 +22,44 @@ func TestNew(t *testing.T) {
 	assert.Truef(t, m.Amount().Equal(expect), "Expected %s got %s", expect.String(), m.Amount().String())
 }
 
+func TestNewStrict(t *testing.T) {
+	m, err := money.NewStrict(100, "USD")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "$1.00", m.Display())
+	}
+
+	_, err = money.NewStrict(100, "UDS")
+	if assert.Error(t, err) {
+		assert.Equal(t, &money.UnknownCurrencyError{Code: "UDS"}, err)
+	}
+
+	_, err = money.NewFromDecimalStrict(decimal.New(1, 0), "U$D")
+	if assert.Error(t, err) {
+		assert.Equal(t, &money.InvalidCodeError{Code: "U$D"}, err)
+	}
+}
+
+func TestValidateCode(t *testing.T) {
+	tcs := []struct {
+		code  string
+		valid bool
+	}{
+		{"USD", true},
+		{"XXX", true},
+		{"usd", false},
+		{"US", false},
+		{"USDT", false},
+		{"US1", false},
+		{"", false},
+	}
+
+	for _, tc := range tcs {
+		err := money.ValidateCode(tc.code)
+
+		assert.Equalf(t, tc.valid, err == nil, "Expected %q validity to be %t got %v", tc.code, tc.valid, err)
+	}
+}
+
 func TestCurrency(t *testing.T) {
 	code := "MOCK"
 	decimals := 5
@@ -518,6 +556,8 @@ func TestMoney_Display(t *testing.T) {
 	}{
 		{100, "AED", "1.00 .\u062f.\u0625"},
 		{1, "USD", "$0.01"},
+		{123456789, "EUR", "\u20ac1,234,567.89"},
+		{-123456789, "EUR", "-\u20ac1,234,567.89"},
 	}
 
 	for _, tc := range tcs {
@@ -624,4 +664,16 @@ func TestMoney_UnmarshalJSON(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "125.22", m.Amount().String())
 	assert.Equal(t, "USD", m.Currency().Code)
-}
\ No newline at end of file
+}
+
+func TestMoney_UnmarshalJSONStrict(t *testing.T) {
+	money.DefaultRegistry.SetStrict(true)
+	defer money.DefaultRegistry.SetStrict(false)
+
+	m := &money.Money{}
+	err := json.Unmarshal([]byte(`{"amount":"125.22","currency":"UDS"}`), m)
+	assert.Equal(t, &money.UnknownCurrencyError{Code: "UDS"}, err)
+
+	err = json.Unmarshal([]byte(`{"amount":"125.22","currency":"USD"}`), m)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,172 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+)
+
+// MoneyBag holds Money of several currencies, e.g. cart or customer balance.
+// Amounts are kept per currency code and added together for the same currency.
+// Zero MoneyBag is an empty bag ready to use
+type MoneyBag struct {
+	amounts map[string]*Money
+}
+
+// NewMoneyBag creates MoneyBag holding the given Money
+func NewMoneyBag(ms ...*Money) (*MoneyBag, error) {
+	b := &MoneyBag{}
+	for _, m := range ms {
+		if err := b.Add(m); err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
+
+// Add adds Money to the amount of its currency
+func (b *MoneyBag) Add(m *Money) error {
+	if m == nil || m.currency == nil {
+		return errors.New("money: cannot add Money without currency to bag")
+	}
+	if b.amounts == nil {
+		b.amounts = map[string]*Money{}
+	}
+
+	code := m.currency.Code
+	if cur, ok := b.amounts[code]; ok {
+		sum, err := cur.Add(m)
+		if err != nil {
+			return err
+		}
+		b.amounts[code] = sum
+		return nil
+	}
+	b.amounts[code] = &Money{amount: m.amount, currency: m.currency, rounding: m.rounding}
+
+	return nil
+}
+
+// Subtract subtracts Money from the amount of its currency, amounts may become negative
+func (b *MoneyBag) Subtract(m *Money) error {
+	if m == nil || m.currency == nil {
+		return errors.New("money: cannot subtract Money without currency from bag")
+	}
+
+	return b.Add(&Money{amount: m.amount.Neg(), currency: m.currency, rounding: m.rounding})
+}
+
+// Negate returns new MoneyBag with all amounts negated
+func (b *MoneyBag) Negate() *MoneyBag {
+	neg := &MoneyBag{amounts: make(map[string]*Money, len(b.amounts))}
+	for code, m := range b.amounts {
+		neg.amounts[code] = &Money{amount: m.amount.Neg(), currency: m.currency, rounding: m.rounding}
+	}
+
+	return neg
+}
+
+// Get returns amount of the currency, nil is returned if the bag holds no Money of the currency
+func (b *MoneyBag) Get(code string) *Money {
+	return b.amounts[code]
+}
+
+// IsZero reports whether all amounts of the bag are zero, empty bag is zero
+func (b *MoneyBag) IsZero() bool {
+	for _, m := range b.amounts {
+		if !m.IsZero() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Len returns the number of currencies in the bag
+func (b *MoneyBag) Len() int {
+	return len(b.amounts)
+}
+
+// Codes returns currency codes of the bag sorted alphabetically
+func (b *MoneyBag) Codes() []string {
+	codes := make([]string, 0, len(b.amounts))
+	for code := range b.amounts {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	return codes
+}
+
+// Moneys returns amounts of the bag sorted by currency code
+func (b *MoneyBag) Moneys() []*Money {
+	codes := b.Codes()
+	ms := make([]*Money, len(codes))
+	for i, code := range codes {
+		ms[i] = b.amounts[code]
+	}
+
+	return ms
+}
+
+// Each calls fn for every amount of the bag in order of currency codes
+func (b *MoneyBag) Each(fn func(m *Money)) {
+	for _, m := range b.Moneys() {
+		fn(m)
+	}
+}
+
+// Total returns sum of all amounts converted to the currency using current rates of the converter
+func (b *MoneyBag) Total(to *Currency, c *Converter) (*Money, error) {
+	return b.TotalAt(to, c, time.Now())
+}
+
+// TotalAt returns sum of all amounts converted to the currency using rates of the converter
+// at the given time. Every amount is rounded by the currency Fraction before it is added
+func (b *MoneyBag) TotalAt(to *Currency, c *Converter, at time.Time) (*Money, error) {
+	if to == nil {
+		return nil, errors.New("money: cannot total bag without currency")
+	}
+
+	total := &Money{currency: to, rounding: DefaultRegistry.Rounding()}
+	for _, m := range b.Moneys() {
+		converted, _, err := c.Convert(m, to, at)
+		if err != nil {
+			return nil, err
+		}
+		if total, err = total.Add(converted); err != nil {
+			return nil, err
+		}
+	}
+
+	return total, nil
+}
+
+// MarshalJSON implements json.Marshaler, bag is written as an array of Money sorted by currency code
+func (b MoneyBag) MarshalJSON() ([]byte, error) {
+	ms := make([]Money, 0, len(b.amounts))
+	for _, m := range b.Moneys() {
+		ms = append(ms, *m)
+	}
+
+	return json.Marshal(ms)
+}
+
+// UnmarshalJSON implements json.Unmarshaler reading an array of Money,
+// Money of the same currency is added together
+func (b *MoneyBag) UnmarshalJSON(data []byte) error {
+	var ms []*Money
+	if err := json.Unmarshal(data, &ms); err != nil {
+		return err
+	}
+
+	bag, err := NewMoneyBag(ms...)
+	if err != nil {
+		return err
+	}
+	*b = *bag
+
+	return nil
+}
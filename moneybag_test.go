@@ -0,0 +1,104 @@
+package money_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/amanbolat/go-money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoneyBag_AddSubtract(t *testing.T) {
+	b, err := money.NewMoneyBag(money.New(1000, "USD"), money.New(500, "EUR"), money.New(250, "USD"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, b.Len())
+	assert.Equal(t, "12.50 USD", b.Get("USD").String())
+	assert.Equal(t, "5.00 EUR", b.Get("EUR").String())
+	assert.Nil(t, b.Get("GBP"))
+
+	require.NoError(t, b.Subtract(money.New(700, "EUR")))
+	require.NoError(t, b.Subtract(money.New(100, "GBP")))
+	assert.Equal(t, "-2.00 EUR", b.Get("EUR").String())
+	assert.Equal(t, "-1.00 GBP", b.Get("GBP").String())
+
+	neg := b.Negate()
+	assert.Equal(t, "2.00 EUR", neg.Get("EUR").String())
+	assert.Equal(t, "-12.50 USD", neg.Get("USD").String())
+	assert.Equal(t, "-2.00 EUR", b.Get("EUR").String())
+
+	assert.Error(t, b.Add(&money.Money{}))
+	assert.Error(t, b.Add(nil))
+	assert.Error(t, b.Subtract(&money.Money{}))
+}
+
+func TestMoneyBag_IsZero(t *testing.T) {
+	var b money.MoneyBag
+	assert.True(t, b.IsZero())
+
+	require.NoError(t, b.Add(money.New(100, "USD")))
+	assert.False(t, b.IsZero())
+
+	require.NoError(t, b.Subtract(money.New(100, "USD")))
+	assert.True(t, b.IsZero())
+	assert.Equal(t, 1, b.Len())
+}
+
+func TestMoneyBag_Order(t *testing.T) {
+	b, err := money.NewMoneyBag(money.New(1, "USD"), money.New(2, "EUR"), money.New(3, "JPY"), money.New(4, "CHF"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CHF", "EUR", "JPY", "USD"}, b.Codes())
+
+	var codes []string
+	b.Each(func(m *money.Money) {
+		codes = append(codes, m.Currency().Code)
+	})
+	assert.Equal(t, b.Codes(), codes)
+
+	ms := b.Moneys()
+	require.Len(t, ms, 4)
+	assert.Equal(t, "0.04 CHF", ms[0].String())
+}
+
+func TestMoneyBag_JSON(t *testing.T) {
+	b, err := money.NewMoneyBag(money.New(1250, "USD"), money.New(500, "EUR"))
+	require.NoError(t, err)
+
+	data, err := json.Marshal(b)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"amount":"5","currency":"EUR"},{"amount":"12.5","currency":"USD"}]`, string(data))
+
+	var r money.MoneyBag
+	require.NoError(t, json.Unmarshal([]byte(`[{"amount":"5","currency":"EUR"},{"amount":"1","currency":"EUR"}]`), &r))
+	assert.Equal(t, "6.00 EUR", r.Get("EUR").String())
+
+	assert.Error(t, json.Unmarshal([]byte(`[null]`), &r))
+	assert.Error(t, json.Unmarshal([]byte(`{}`), &r))
+}
+
+func TestMoneyBag_Total(t *testing.T) {
+	rt := money.NewRateTable("USD")
+	require.NoError(t, rt.SetRate("EUR", "USD", mustDecimal("1.1")))
+	require.NoError(t, rt.SetRate("GBP", "USD", mustDecimal("1.25")))
+	c := money.NewConverter(rt, 0)
+
+	b, err := money.NewMoneyBag(money.New(1000, "USD"), money.New(1000, "EUR"), money.New(-400, "GBP"))
+	require.NoError(t, err)
+
+	total, err := b.Total(money.GetCurrency("USD"), c)
+	require.NoError(t, err)
+	assert.Equal(t, "16.00 USD", total.String())
+
+	total, err = b.TotalAt(money.GetCurrency("EUR"), c, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, "14.54 EUR", total.String())
+
+	empty, err := (&money.MoneyBag{}).Total(money.GetCurrency("USD"), c)
+	require.NoError(t, err)
+	assert.Equal(t, "0.00 USD", empty.String())
+
+	require.NoError(t, b.Add(money.New(100, "JPY")))
+	_, err = b.Total(money.GetCurrency("USD"), c)
+	assert.Error(t, err)
+}
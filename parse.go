@@ -0,0 +1,413 @@
+package money
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/shopspring/decimal"
+)
+
+// ParseOptions configures how Parse reads formatted money strings
+type ParseOptions struct {
+	// Currency is a currency code used when the string has no currency
+	// or its symbol is shared by several currencies, e.g. "$"
+	Currency string
+	// Locale sets decimal and group separators and currency symbols, e.g. "de-DE"
+	Locale string
+	// Strict rejects surrounding whitespace, lower case codes, misplaced
+	// separators and fraction digits exceeding currency Fraction
+	Strict bool
+}
+
+// ParseError describes a problem parsing money string
+type ParseError struct {
+	Input  string
+	Offset int
+	Msg    string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("money: parsing %q: %s at offset %d", e.Input, e.Msg, e.Offset)
+}
+
+// Parse returns Money represented by the formatted string, it is the inverse of Display.
+// Strings like "$1,234.56", "1 234,56 €", "-£0.99" or "USD 12.00" are accepted.
+// Currency is recognised by ISO code or grapheme, ambiguous graphemes like "$" require
+// ParseOptions.Currency hint, "£" stands for GBP unless hint is given. Options may be nil
+func Parse(s string, opts *ParseOptions) (*Money, error) {
+	return DefaultRegistry.Parse(s, opts)
+}
+
+// Parse returns Money represented by the formatted string using registry currencies
+func (r *Registry) Parse(s string, opts *ParseOptions) (*Money, error) {
+	if opts == nil {
+		opts = &ParseOptions{}
+	}
+
+	p := &parser{input: s, opts: opts, registry: r}
+	if opts.Locale != "" {
+		p.locale = GetLocale(opts.Locale)
+		if p.locale == nil {
+			return nil, fmt.Errorf("money: unknown locale %q", opts.Locale)
+		}
+	}
+
+	return p.parse()
+}
+
+type parser struct {
+	input    string
+	opts     *ParseOptions
+	locale   *Locale
+	registry *Registry
+}
+
+func (p *parser) errorf(offset int, format string, args ...interface{}) error {
+	return &ParseError{Input: p.input, Offset: offset, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) parse() (*Money, error) {
+	s := p.input
+
+	start := strings.IndexFunc(s, isDigit)
+	if start < 0 {
+		return nil, p.errorf(len(s), "missing amount")
+	}
+	end := strings.LastIndexFunc(s, isDigit) + 1
+
+	if p.opts.Strict {
+		if r, _ := utf8.DecodeRuneInString(s); isSpace(r) {
+			return nil, p.errorf(0, "unexpected whitespace")
+		}
+		if r, size := utf8.DecodeLastRuneInString(s); isSpace(r) {
+			return nil, p.errorf(len(s)-size, "unexpected whitespace")
+		}
+	}
+
+	negative, prefix, prefixOffset, err := p.affix(s[:start], 0, true)
+	if err != nil {
+		return nil, err
+	}
+
+	_, suffix, suffixOffset, err := p.affix(s[end:], end, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if prefix != "" && suffix != "" {
+		return nil, p.errorf(suffixOffset, "unexpected currency %q", suffix)
+	}
+
+	symbol, offset := prefix, prefixOffset
+	if suffix != "" {
+		symbol, offset = suffix, suffixOffset
+	}
+
+	c, err := p.currency(symbol, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := p.number(s[start:end], start, c)
+	if err != nil {
+		return nil, err
+	}
+
+	if negative {
+		amount = amount.Neg()
+	}
+
+	m, err := p.registry.newMoney(amount, c, 0)
+	if err != nil {
+		return nil, p.errorf(start, "%v", err)
+	}
+
+	return m, nil
+}
+
+// affix reads sign and currency symbol around the number.
+// Sign is accepted only before the number, in strict mode only as the first character
+func (p *parser) affix(s string, base int, leading bool) (bool, string, int, error) {
+	var negative, signed bool
+	sign, symbolStart, symbolEnd := -1, -1, -1
+
+	for i, r := range s {
+		switch {
+		case isSpace(r):
+		case r == '-' || r == '\u2212' || r == '+':
+			if !leading || signed || (p.opts.Strict && base+i != 0) {
+				return false, "", 0, p.errorf(base+i, "unexpected sign %q", r)
+			}
+			signed, sign = true, i
+			negative = r != '+'
+		default:
+			if symbolStart < 0 {
+				symbolStart = i
+			}
+			symbolEnd = i + utf8.RuneLen(r)
+		}
+	}
+
+	if symbolStart < 0 {
+		return negative, "", base, nil
+	}
+
+	if signed && sign > symbolStart && sign < symbolEnd {
+		r, _ := utf8.DecodeRuneInString(s[sign:])
+		return false, "", 0, p.errorf(base+sign, "unexpected sign %q", r)
+	}
+
+	return negative, s[symbolStart:symbolEnd], base + symbolStart, nil
+}
+
+// currency resolves currency by ISO code or grapheme
+func (p *parser) currency(symbol string, offset int) (*Currency, error) {
+	hint := strings.ToUpper(p.opts.Currency)
+
+	if symbol == "" {
+		if hint == "" {
+			return nil, p.errorf(offset, "missing currency")
+		}
+		if c := p.registry.GetCurrency(hint); c != nil {
+			return c, nil
+		}
+
+		return nil, p.errorf(offset, "unknown currency %q", p.opts.Currency)
+	}
+
+	if isCode(symbol) {
+		code := strings.ToUpper(symbol)
+		if p.opts.Strict && code != symbol {
+			return nil, p.errorf(offset, "currency code %q must be upper case", symbol)
+		}
+		if c := p.registry.GetCurrency(code); c != nil {
+			return c, nil
+		}
+	}
+
+	candidates := p.symbolCandidates(symbol)
+	switch {
+	case len(candidates) == 1:
+		return p.registry.GetCurrency(candidates[0]), nil
+	case len(candidates) > 1:
+		for _, code := range candidates {
+			if code == hint || (hint == "" && code == preferredSymbols[symbol]) {
+				return p.registry.GetCurrency(code), nil
+			}
+		}
+
+		return nil, p.errorf(offset, "ambiguous currency symbol %q, currency hint required", symbol)
+	}
+
+	return nil, p.errorf(offset, "unknown currency %q", symbol)
+}
+
+// preferredSymbols maps symbols shared by several currencies to the currency they denote
+// without hint. "\u00a3" is GBP, other currencies use it locally or are pegged to GBP.
+// "$" has no preferred currency, it is shared by too many of them
+var preferredSymbols = map[string]string{
+	"\u00a3": "GBP",
+}
+
+// symbolCandidates returns sorted codes of currencies using the symbol.
+// Locale symbols are preferred to currency graphemes
+func (p *parser) symbolCandidates(symbol string) []string {
+	var codes []string
+
+	if p.locale != nil && p.locale.data != nil {
+		for _, narrow := range []bool{false, true} {
+			for code, s := range p.locale.data.Symbols {
+				if (!narrow && s.Standard == symbol) || (narrow && s.Narrow == symbol) {
+					codes = append(codes, code)
+				}
+			}
+			if len(codes) > 0 {
+				sort.Strings(codes)
+				return codes
+			}
+		}
+	}
+
+	for _, c := range p.registry.Currencies() {
+		if c.Grapheme == symbol {
+			codes = append(codes, c.Code)
+		}
+	}
+
+	return codes
+}
+
+// group represents digits between separators of the integer part
+type group struct {
+	offset int
+	size   int
+}
+
+// number parses digits and separators of the amount
+func (p *parser) number(s string, base int, c *Currency) (decimal.Decimal, error) {
+	dec, sep := p.separators(s, c)
+
+	var integer, fraction []byte
+	var groups []group
+	current := group{offset: base}
+	seenDecimal := false
+
+	for i, r := range s {
+		switch {
+		case isDigit(r):
+			if seenDecimal {
+				if p.opts.Strict && len(fraction) >= c.Fraction {
+					return decimal.Zero, p.errorf(base+i, "too many fraction digits for %s", c.Code)
+				}
+				fraction = append(fraction, byte(r))
+			} else {
+				integer = append(integer, byte(r))
+			}
+			current.size++
+		case matchSeparator(r, dec, false):
+			if seenDecimal {
+				return decimal.Zero, p.errorf(base+i, "unexpected decimal separator %q", r)
+			}
+			seenDecimal = true
+			groups = append(groups, current)
+		case matchSeparator(r, sep, !p.opts.Strict):
+			if seenDecimal || current.size == 0 {
+				return decimal.Zero, p.errorf(base+i, "unexpected group separator %q", r)
+			}
+			groups = append(groups, current)
+			current = group{offset: base + i + utf8.RuneLen(r)}
+		default:
+			return decimal.Zero, p.errorf(base+i, "unexpected character %q", r)
+		}
+	}
+
+	if !seenDecimal {
+		groups = append(groups, current)
+	}
+
+	if p.opts.Strict {
+		if err := p.checkGroups(groups); err != nil {
+			return decimal.Zero, err
+		}
+	}
+
+	str := string(integer)
+	if len(fraction) > 0 {
+		str += "." + string(fraction)
+	}
+
+	return decimal.NewFromString(str)
+}
+
+// checkGroups validates sizes of digit groups in the integer part
+func (p *parser) checkGroups(groups []group) error {
+	if len(groups) < 2 {
+		return nil
+	}
+
+	primary, secondary := 3, 0
+	if p.locale != nil && p.locale.Group > 0 {
+		primary, secondary = p.locale.Group, p.locale.SecondaryGroup
+	}
+	if secondary <= 0 {
+		secondary = primary
+	}
+
+	for i, g := range groups {
+		expected := secondary
+		if i == len(groups)-1 {
+			expected = primary
+		}
+
+		if (i == 0 && g.size > expected) || (i > 0 && g.size != expected) {
+			return p.errorf(g.offset, "invalid digit grouping")
+		}
+	}
+
+	return nil
+}
+
+// separators returns decimal and group separators of the number.
+// Locale separators are used when locale is set, strict mode uses currency separators,
+// otherwise they are guessed from the number itself
+func (p *parser) separators(s string, c *Currency) (string, string) {
+	if p.locale != nil {
+		return p.locale.Decimal, p.locale.Thousand
+	}
+
+	if p.opts.Strict {
+		dec, group := c.Decimal, c.Thousand
+		if dec == "" {
+			dec = "."
+		}
+
+		return dec, group
+	}
+
+	lastDot, lastComma := strings.LastIndex(s, "."), strings.LastIndex(s, ",")
+	switch {
+	case lastDot >= 0 && lastComma >= 0:
+		if lastDot > lastComma {
+			return ".", ","
+		}
+		return ",", "."
+	case lastDot < 0 && lastComma < 0:
+		return ".", ","
+	}
+
+	sep, other, last := ".", ",", lastDot
+	if lastComma >= 0 {
+		sep, other, last = ",", ".", lastComma
+	}
+
+	// separator used several times is grouping, a single one followed by exactly
+	// three digits is grouping too unless it is the currency decimal separator
+	if strings.Count(s, sep) > 1 {
+		return other, sep
+	}
+	if len(s)-last-1 == 3 && sep != c.Decimal && !strings.ContainsAny(s, " \u00a0\u202f'\u2019") {
+		return other, sep
+	}
+
+	return sep, other
+}
+
+// matchSeparator reports whether rune is the separator.
+// All kinds of spaces match each other, loose mode also accepts spaces and apostrophes as group separator
+func matchSeparator(r rune, sep string, loose bool) bool {
+	if sep == "" {
+		return false
+	}
+
+	s, _ := utf8.DecodeRuneInString(sep)
+	if r == s || (isSpace(r) && isSpace(s)) {
+		return true
+	}
+
+	return loose && (isSpace(r) || r == '\'' || r == '\u2019')
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isSpace(r rune) bool {
+	return unicode.IsSpace(r)
+}
+
+func isCode(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+
+	for _, r := range s {
+		if !(r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z') {
+			return false
+		}
+	}
+
+	return true
+}
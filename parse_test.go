@@ -0,0 +1,100 @@
+package money_test
+
+import (
+	"testing"
+
+	"github.com/amanbolat/go-money"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tcs := []struct {
+		input    string
+		opts     *money.ParseOptions
+		expected decimal.Decimal
+		code     string
+	}{
+		{"$1,234.56", &money.ParseOptions{Currency: "USD"}, decimal.New(123456, -2), "USD"},
+		{"1 234,56 €", nil, decimal.New(123456, -2), "EUR"},
+		{"-£0.99", nil, decimal.New(-99, -2), "GBP"},
+		{"-£0.99", &money.ParseOptions{Currency: "GBP"}, decimal.New(-99, -2), "GBP"},
+		{"-£0.99", &money.ParseOptions{Locale: "en-GB"}, decimal.New(-99, -2), "GBP"},
+		{"USD 12.00", nil, decimal.New(1200, -2), "USD"},
+		{"12.00usd", nil, decimal.New(1200, -2), "USD"},
+		{"€1.234.567,89", nil, decimal.New(123456789, -2), "EUR"},
+		{"1.234.567,89 €", &money.ParseOptions{Locale: "de-DE", Strict: true}, decimal.New(123456789, -2), "EUR"},
+		{"¥1,234", nil, decimal.New(1234, 0), "JPY"},
+		{"1.234 BHD", nil, decimal.New(1234, -3), "BHD"},
+		{"1'234.50 USD", nil, decimal.New(123450, -2), "USD"},
+		{"12.345 USD", nil, decimal.New(1235, -2), "USD"},
+		{"US$12.30", &money.ParseOptions{Locale: "en-CA"}, decimal.New(1230, -2), "USD"},
+		{"12", &money.ParseOptions{Currency: "eur"}, decimal.New(1200, -2), "EUR"},
+		{"+ 5 EUR", nil, decimal.New(500, -2), "EUR"},
+		{"£5", &money.ParseOptions{Currency: "EGP"}, decimal.New(500, -2), "EGP"},
+	}
+
+	for _, tc := range tcs {
+		m, err := money.Parse(tc.input, tc.opts)
+
+		if assert.NoErrorf(t, err, "Parsing %q", tc.input) {
+			assert.Truef(t, tc.expected.Equal(m.Amount()), "Expected %q to be %s got %s", tc.input, tc.expected.String(), m.Amount().String())
+			assert.Equalf(t, tc.code, m.Currency().Code, "Expected %q currency to be %s", tc.input, tc.code)
+		}
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tcs := []struct {
+		input  string
+		opts   *money.ParseOptions
+		offset int
+	}{
+		{"$1,234.56", nil, 0},
+		{"£5", &money.ParseOptions{Currency: "EUR"}, 0},
+		{"1,234.56", nil, 0},
+		{"EUR", nil, 3},
+		{"€12.3x", nil, 7},
+		{"€12.3 x", nil, 8},
+		{"€1,2.3,4", nil, 6},
+		{"€12-", nil, 5},
+		{"€12 USD", nil, 6},
+		{" €12", &money.ParseOptions{Strict: true}, 0},
+		{"€12 ", &money.ParseOptions{Strict: true}, 5},
+		{"12.00 usd", &money.ParseOptions{Strict: true}, 6},
+		{"12.345 USD", &money.ParseOptions{Strict: true}, 5},
+		{"€-12", &money.ParseOptions{Strict: true}, 3},
+		{"1 234,56", &money.ParseOptions{Locale: "fr-FR"}, 0},
+		{"1,23,456.00 USD", &money.ParseOptions{Strict: true}, 2},
+		{"1.234,56 €", &money.ParseOptions{Strict: true}, 4},
+		{"12.00 UDS", nil, 6},
+	}
+
+	for _, tc := range tcs {
+		m, err := money.Parse(tc.input, tc.opts)
+
+		assert.Nilf(t, m, "Expected %q not to be parsed", tc.input)
+		if perr, ok := err.(*money.ParseError); assert.Truef(t, ok, "Expected ParseError for %q got %v", tc.input, err) {
+			assert.Equalf(t, tc.offset, perr.Offset, "Expected %q error offset, got: %v", tc.input, err)
+		}
+	}
+}
+
+func TestParse_UnknownLocale(t *testing.T) {
+	_, err := money.Parse("€12", &money.ParseOptions{Locale: "xx-XX"})
+
+	assert.Error(t, err)
+}
+
+func TestParse_Display(t *testing.T) {
+	for _, code := range []string{"USD", "EUR", "GBP", "JPY", "AED", "BHD"} {
+		m := money.New(-123456789, code)
+		r, err := money.Parse(m.Display(), &money.ParseOptions{Currency: code})
+
+		if assert.NoError(t, err) {
+			ok, err := r.Equals(m)
+			assert.NoError(t, err)
+			assert.Truef(t, ok, "Expected %s got %s", m.Display(), r.Display())
+		}
+	}
+}
@@ -0,0 +1,315 @@
+// Package pgxmoney maps PostgreSQL composite type
+//
+//	CREATE TYPE money_t AS (amount numeric, currency char(3));
+//
+// to *money.Money using pgx. Both text and binary formats are supported,
+// arrays of the composite type are scanned into []money.Money or []*money.Money.
+package pgxmoney
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+
+	"github.com/amanbolat/go-money"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+// Codec is pgtype.Codec of the money composite type. It encodes money.Money and *money.Money
+// and scans into *money.Money and **money.Money, NULL is scanned as nil *money.Money
+type Codec struct {
+	composite *pgtype.CompositeCodec
+	registry  *money.Registry
+	amount    int
+	currency  int
+}
+
+// NewCodec wraps composite codec of the money type, it must have "amount" and "currency" fields.
+// Currencies are looked up in the registry, money.DefaultRegistry is used if registry is nil
+func NewCodec(composite *pgtype.CompositeCodec, registry *money.Registry) (*Codec, error) {
+	if registry == nil {
+		registry = money.DefaultRegistry
+	}
+
+	c := &Codec{composite: composite, registry: registry, amount: -1, currency: -1}
+	for i, f := range composite.Fields {
+		switch f.Name {
+		case "amount":
+			c.amount = i
+		case "currency":
+			c.currency = i
+		}
+	}
+	if len(composite.Fields) != 2 || c.amount < 0 || c.currency < 0 {
+		return nil, fmt.Errorf("pgxmoney: composite type must have amount and currency fields")
+	}
+
+	return c, nil
+}
+
+// Register loads the composite type and its array type from the database
+// and registers them in the connection type map, e.g. Register(ctx, conn, "money_t")
+func Register(ctx context.Context, conn *pgx.Conn, name string) error {
+	t, err := conn.LoadType(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	composite, ok := t.Codec.(*pgtype.CompositeCodec)
+	if !ok {
+		return fmt.Errorf("pgxmoney: %s is not a composite type", name)
+	}
+
+	codec, err := NewCodec(composite, nil)
+	if err != nil {
+		return err
+	}
+	conn.TypeMap().RegisterType(&pgtype.Type{Name: t.Name, OID: t.OID, Codec: codec})
+
+	array, err := conn.LoadType(ctx, "_"+name)
+	if err != nil {
+		return err
+	}
+	conn.TypeMap().RegisterType(array)
+
+	return nil
+}
+
+// RegisterTypes registers composite type of numeric amount and char(3) currency and its array type
+// with known oids in the type map. It is useful when oids are loaded once and shared by connections
+func RegisterTypes(m *pgtype.Map, name string, oid, arrayOID uint32, registry *money.Registry) error {
+	numeric, ok := m.TypeForName("numeric")
+	if !ok {
+		return fmt.Errorf("pgxmoney: numeric type is not registered")
+	}
+	bpchar, ok := m.TypeForName("bpchar")
+	if !ok {
+		return fmt.Errorf("pgxmoney: bpchar type is not registered")
+	}
+
+	codec, err := NewCodec(&pgtype.CompositeCodec{Fields: []pgtype.CompositeCodecField{
+		{Name: "amount", Type: numeric},
+		{Name: "currency", Type: bpchar},
+	}}, registry)
+	if err != nil {
+		return err
+	}
+
+	t := &pgtype.Type{Name: name, OID: oid, Codec: codec}
+	m.RegisterType(t)
+	m.RegisterType(&pgtype.Type{Name: "_" + name, OID: arrayOID, Codec: &pgtype.ArrayCodec{ElementType: t}})
+
+	return nil
+}
+
+// FormatSupported reports whether the composite codec supports the format
+func (c *Codec) FormatSupported(format int16) bool {
+	return c.composite.FormatSupported(format)
+}
+
+// PreferredFormat returns format preferred by the composite codec
+func (c *Codec) PreferredFormat() int16 {
+	return c.composite.PreferredFormat()
+}
+
+// PlanEncode returns plan encoding money.Money and *money.Money as the composite type,
+// nil is returned for other values
+func (c *Codec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	switch value.(type) {
+	case money.Money, *money.Money:
+	default:
+		return nil
+	}
+
+	next := c.composite.PlanEncode(m, oid, format, &fields{codec: c})
+	if next == nil {
+		return nil
+	}
+
+	return &encodePlan{codec: c, next: next}
+}
+
+type encodePlan struct {
+	codec *Codec
+	next  pgtype.EncodePlan
+}
+
+// Encode writes amount and currency fields, nil *money.Money is encoded as NULL.
+// Amount with more fraction digits than currency Fraction is rejected, so every
+// encoded value can be scanned back
+func (p *encodePlan) Encode(value any, buf []byte) ([]byte, error) {
+	var m *money.Money
+	switch v := value.(type) {
+	case money.Money:
+		m = &v
+	case *money.Money:
+		m = v
+	}
+
+	if m == nil {
+		return nil, nil
+	}
+	if m.Currency() == nil {
+		return nil, fmt.Errorf("pgxmoney: cannot encode Money without currency")
+	}
+	if _, err := m.RoundWithMode(int32(m.Currency().Fraction), money.Unnecessary); err != nil {
+		return nil, fmt.Errorf("pgxmoney: amount %s has more fraction digits than %s allows: %w",
+			m.Amount(), m.Currency().Code, err)
+	}
+
+	f := &fields{codec: p.codec}
+	f.set(m)
+
+	return p.next.Encode(f, buf)
+}
+
+// PlanScan returns plan scanning the composite type into *money.Money and **money.Money,
+// nil is returned for other targets
+func (c *Codec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	switch target.(type) {
+	case *money.Money, **money.Money:
+	default:
+		return nil
+	}
+
+	next := c.composite.PlanScan(m, oid, format, &fields{codec: c})
+	if next == nil {
+		return nil
+	}
+
+	return &scanPlan{codec: c, next: next}
+}
+
+type scanPlan struct {
+	codec *Codec
+	next  pgtype.ScanPlan
+}
+
+// Scan reads amount and currency fields into the target. Error is returned if currency
+// is unknown to strict registry or amount has more fraction digits than currency Fraction
+func (p *scanPlan) Scan(src []byte, target any) error {
+	f := &fields{codec: p.codec}
+	if err := p.next.Scan(src, f); err != nil {
+		return err
+	}
+
+	var m *money.Money
+	if !f.null {
+		var err error
+		if m, err = f.money(); err != nil {
+			return err
+		}
+	}
+
+	switch t := target.(type) {
+	case **money.Money:
+		*t = m
+	case *money.Money:
+		if m == nil {
+			return fmt.Errorf("pgxmoney: cannot scan NULL into *money.Money")
+		}
+		*t = *m
+	}
+
+	return nil
+}
+
+// DecodeDatabaseSQLValue returns Money as text like "12.34 USD" which is read by money.Money Scan
+func (c *Codec) DecodeDatabaseSQLValue(m *pgtype.Map, oid uint32, format int16, src []byte) (driver.Value, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	var mm money.Money
+	if err := c.PlanScan(m, oid, format, &mm).Scan(src, &mm); err != nil {
+		return nil, err
+	}
+
+	return mm.Value()
+}
+
+// DecodeValue returns *money.Money
+func (c *Codec) DecodeValue(m *pgtype.Map, oid uint32, format int16, src []byte) (any, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	var mm *money.Money
+	if err := c.PlanScan(m, oid, format, &mm).Scan(src, &mm); err != nil {
+		return nil, err
+	}
+
+	return mm, nil
+}
+
+// fields adapts Money to composite codec
+type fields struct {
+	codec    *Codec
+	amount   pgtype.Numeric
+	currency pgtype.Text
+	null     bool
+}
+
+func (f *fields) set(m *money.Money) {
+	amount := m.Amount()
+	f.amount = pgtype.Numeric{Int: amount.Coefficient(), Exp: amount.Exponent(), Valid: true}
+	f.currency = pgtype.Text{String: m.Currency().Code, Valid: true}
+}
+
+func (f *fields) money() (*money.Money, error) {
+	if !f.amount.Valid || !f.currency.Valid {
+		return nil, fmt.Errorf("pgxmoney: amount and currency must not be NULL")
+	}
+	if f.amount.NaN || f.amount.InfinityModifier != pgtype.Finite {
+		return nil, fmt.Errorf("pgxmoney: amount must be a finite number")
+	}
+
+	amount := decimal.NewFromBigInt(f.amount.Int, f.amount.Exp)
+	code := strings.TrimSpace(f.currency.String)
+
+	r := f.codec.registry
+	if r.Strict() {
+		if _, err := r.Lookup(code); err != nil {
+			return nil, err
+		}
+	}
+
+	m, err := r.NewFromDecimalWithMode(amount, code, money.Unnecessary)
+	if err != nil {
+		return nil, fmt.Errorf("pgxmoney: amount %s has more fraction digits than %s allows: %w", amount, code, err)
+	}
+
+	return m, nil
+}
+
+// IsNull implements pgtype.CompositeIndexGetter
+func (f *fields) IsNull() bool {
+	return f.null
+}
+
+// Index implements pgtype.CompositeIndexGetter
+func (f *fields) Index(i int) any {
+	if i == f.codec.amount {
+		return f.amount
+	}
+
+	return f.currency
+}
+
+// ScanNull implements pgtype.CompositeIndexScanner
+func (f *fields) ScanNull() error {
+	f.null = true
+	return nil
+}
+
+// ScanIndex implements pgtype.CompositeIndexScanner
+func (f *fields) ScanIndex(i int) any {
+	if i == f.codec.amount {
+		return &f.amount
+	}
+
+	return &f.currency
+}
@@ -0,0 +1,151 @@
+package pgxmoney_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/amanbolat/go-money"
+	"github.com/amanbolat/go-money/pgxmoney"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	moneyOID      = 90001
+	moneyArrayOID = 90002
+)
+
+func newMap(t *testing.T) *pgtype.Map {
+	m := pgtype.NewMap()
+	require.NoError(t, pgxmoney.RegisterTypes(m, "money_t", moneyOID, moneyArrayOID, nil))
+
+	return m
+}
+
+func TestCodec_RoundTrip(t *testing.T) {
+	m := newMap(t)
+
+	for _, format := range []int16{pgtype.TextFormatCode, pgtype.BinaryFormatCode} {
+		for _, value := range []*money.Money{money.New(1234, "USD"), money.New(-5, "EUR"), money.New(1000, "JPY")} {
+			buf, err := m.Encode(moneyOID, format, value, nil)
+			require.NoError(t, err)
+
+			var ptr *money.Money
+			require.NoError(t, m.Scan(moneyOID, format, buf, &ptr))
+			assert.Equal(t, value.Currency().Code, ptr.Currency().Code)
+			assert.True(t, value.Amount().Equal(ptr.Amount()), "format %d: %s", format, ptr.Amount())
+
+			var val money.Money
+			require.NoError(t, m.Scan(moneyOID, format, buf, &val))
+			assert.True(t, value.Amount().Equal(val.Amount()))
+
+			buf, err = m.Encode(moneyOID, format, *value, nil)
+			require.NoError(t, err)
+			require.NoError(t, m.Scan(moneyOID, format, buf, &ptr))
+			assert.True(t, value.Amount().Equal(ptr.Amount()))
+		}
+
+		_, err := m.Encode(moneyOID, format, money.New(100, "USD").Divide(8), nil)
+		assert.True(t, errors.Is(err, money.ErrRoundingNecessary), "%v", err)
+	}
+}
+
+func TestCodec_Text(t *testing.T) {
+	m := newMap(t)
+
+	buf, err := m.Encode(moneyOID, pgtype.TextFormatCode, money.New(1230, "USD"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "(12.30,USD)", string(buf))
+
+	var mm *money.Money
+	require.NoError(t, m.Scan(moneyOID, pgtype.TextFormatCode, []byte(`(1.050,"EUR")`), &mm))
+	assert.Equal(t, "EUR", mm.Currency().Code)
+	assert.Equal(t, "1.05", mm.Amount().String())
+
+	err = m.Scan(moneyOID, pgtype.TextFormatCode, []byte(`(1.005,"EUR")`), &mm)
+	assert.True(t, errors.Is(err, money.ErrRoundingNecessary), "%v", err)
+
+	assert.Error(t, m.Scan(moneyOID, pgtype.TextFormatCode, []byte(`(,EUR)`), &mm))
+	assert.Error(t, m.Scan(moneyOID, pgtype.TextFormatCode, []byte(`(NaN,EUR)`), &mm))
+}
+
+func TestCodec_Null(t *testing.T) {
+	m := newMap(t)
+
+	buf, err := m.Encode(moneyOID, pgtype.BinaryFormatCode, (*money.Money)(nil), nil)
+	require.NoError(t, err)
+	assert.Nil(t, buf)
+
+	mm := money.New(1, "USD")
+	require.NoError(t, m.Scan(moneyOID, pgtype.BinaryFormatCode, nil, &mm))
+	assert.Nil(t, mm)
+
+	var val money.Money
+	assert.Error(t, m.Scan(moneyOID, pgtype.BinaryFormatCode, nil, &val))
+}
+
+func TestCodec_Array(t *testing.T) {
+	m := newMap(t)
+	values := []*money.Money{money.New(100, "USD"), nil, money.New(250, "EUR")}
+
+	for _, format := range []int16{pgtype.TextFormatCode, pgtype.BinaryFormatCode} {
+		buf, err := m.Encode(moneyArrayOID, format, values, nil)
+		require.NoError(t, err)
+
+		var scanned []*money.Money
+		require.NoError(t, m.Scan(moneyArrayOID, format, buf, &scanned))
+		if assert.Len(t, scanned, 3) {
+			assert.Equal(t, "1", scanned[0].Amount().String())
+			assert.Nil(t, scanned[1])
+			assert.Equal(t, "EUR", scanned[2].Currency().Code)
+		}
+
+		buf, err = m.Encode(moneyArrayOID, format, []money.Money{*values[0], *values[2]}, nil)
+		require.NoError(t, err)
+
+		var vals []money.Money
+		require.NoError(t, m.Scan(moneyArrayOID, format, buf, &vals))
+		assert.Len(t, vals, 2)
+	}
+}
+
+func TestCodec_DecodeValue(t *testing.T) {
+	m := newMap(t)
+	buf, err := m.Encode(moneyOID, pgtype.BinaryFormatCode, money.New(1234, "USD"), nil)
+	require.NoError(t, err)
+
+	typ, _ := m.TypeForOID(moneyOID)
+
+	v, err := typ.Codec.DecodeValue(m, moneyOID, pgtype.BinaryFormatCode, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "12.34", v.(*money.Money).Amount().String())
+
+	sv, err := typ.Codec.DecodeDatabaseSQLValue(m, moneyOID, pgtype.BinaryFormatCode, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "12.34 USD", sv)
+}
+
+func TestCodec_Registry(t *testing.T) {
+	r := money.NewEmptyRegistry()
+	r.AddCurrency("PTS", "P", "1 $", ".", ",", 0)
+	r.SetStrict(true)
+
+	m := pgtype.NewMap()
+	require.NoError(t, pgxmoney.RegisterTypes(m, "money_t", moneyOID, moneyArrayOID, r))
+
+	var mm *money.Money
+	require.NoError(t, m.Scan(moneyOID, pgtype.TextFormatCode, []byte(`(12.00,PTS)`), &mm))
+	assert.Equal(t, "12", mm.Amount().String())
+
+	err := m.Scan(moneyOID, pgtype.TextFormatCode, []byte(`(12.6,PTS)`), &mm)
+	assert.True(t, errors.Is(err, money.ErrRoundingNecessary), "%v", err)
+
+	err = m.Scan(moneyOID, pgtype.TextFormatCode, []byte(`(12,USD)`), &mm)
+	assert.IsType(t, &money.UnknownCurrencyError{}, err)
+}
+
+func TestNewCodec(t *testing.T) {
+	_, err := pgxmoney.NewCodec(&pgtype.CompositeCodec{Fields: []pgtype.CompositeCodecField{{Name: "value"}}}, nil)
+	assert.Error(t, err)
+}
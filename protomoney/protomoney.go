@@ -0,0 +1,172 @@
+// Package protomoney converts *money.Money to and from google.type.Money protocol buffers message
+//
+//	message Money { string currency_code = 1; int64 units = 2; int32 nanos = 3; }
+//
+// and to and from messages holding amount in minor units of the currency like
+//
+//	message Amount { string currency_code = 1; int64 minor_units = 2; }
+//
+// Conversions refuse to lose precision, WithMode variants round amount explicitly.
+// Currencies are looked up in money.DefaultRegistry and must be registered,
+// nil Money and nil messages are refused with an error.
+package protomoney
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/amanbolat/go-money"
+	"github.com/shopspring/decimal"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+)
+
+// nanosDigits is the number of fraction digits google.type.Money holds
+const nanosDigits = 9
+
+var (
+	maxUnits = decimal.New(math.MaxInt64, 0)
+	minUnits = decimal.New(math.MinInt64, 0)
+)
+
+var (
+	errNilMoney   = errors.New("protomoney: cannot convert nil Money")
+	errNilMessage = errors.New("protomoney: cannot convert nil message")
+)
+
+// ToProto converts Money to google.type.Money.
+// Error is returned if Money is nil, amount has more than 9 fraction digits or units overflow int64
+func ToProto(m *money.Money) (*moneypb.Money, error) {
+	return ToProtoWithMode(m, money.Unnecessary)
+}
+
+// ToProtoWithMode converts Money to google.type.Money like ToProto, but amount is rounded
+// to 9 fraction digits using the rounding mode, zero mode stands for RoundingMode of Money
+func ToProtoWithMode(m *money.Money, mode money.RoundingMode) (*moneypb.Money, error) {
+	if m == nil {
+		return nil, errNilMoney
+	}
+	if m.Currency() == nil {
+		return nil, fmt.Errorf("protomoney: cannot convert Money without currency")
+	}
+
+	r, err := m.RoundWithMode(nanosDigits, mode)
+	if err != nil {
+		return nil, fmt.Errorf("protomoney: %s has more than %d fraction digits: %w", m, nanosDigits, err)
+	}
+
+	amount := r.Amount()
+	units := amount.Truncate(0)
+	if units.GreaterThan(maxUnits) || units.LessThan(minUnits) {
+		return nil, fmt.Errorf("protomoney: %s overflows units", m)
+	}
+
+	return &moneypb.Money{
+		CurrencyCode: m.Currency().Code,
+		Units:        units.IntPart(),
+		Nanos:        int32(amount.Sub(units).Shift(nanosDigits).IntPart()),
+	}, nil
+}
+
+// FromProto converts google.type.Money to Money.
+// Error is returned if the message is nil or invalid, currency is unknown
+// or amount has more fraction digits than currency Fraction
+func FromProto(pb *moneypb.Money) (*money.Money, error) {
+	return FromProtoWithMode(pb, money.Unnecessary)
+}
+
+// FromProtoWithMode converts google.type.Money to Money like FromProto, but amount is rounded
+// by currency Fraction using the rounding mode, zero mode stands for the default one
+func FromProtoWithMode(pb *moneypb.Money, mode money.RoundingMode) (*money.Money, error) {
+	if pb == nil {
+		return nil, errNilMessage
+	}
+	if err := Validate(pb); err != nil {
+		return nil, err
+	}
+
+	amount := decimal.New(pb.GetUnits(), 0).Add(decimal.New(int64(pb.GetNanos()), -nanosDigits))
+
+	return newMoney(amount, pb.GetCurrencyCode(), mode)
+}
+
+// Validate checks google.type.Money message: nanos must be in range of
+// -999,999,999 to +999,999,999 and have the same sign as units if units are not zero
+func Validate(pb *moneypb.Money) error {
+	units, nanos := pb.GetUnits(), pb.GetNanos()
+
+	if nanos <= -1e9 || nanos >= 1e9 {
+		return fmt.Errorf("protomoney: nanos %d out of range", nanos)
+	}
+	if (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+		return fmt.Errorf("protomoney: units %d and nanos %d have different signs", units, nanos)
+	}
+
+	return nil
+}
+
+// MinorUnitsMessage is implemented by generated messages holding amount in minor units,
+// e.g. message with currency_code and minor_units fields
+type MinorUnitsMessage interface {
+	GetCurrencyCode() string
+	GetMinorUnits() int64
+}
+
+// ToMinorUnits returns amount in minor units and currency code of Money to fill
+// a minor units message. Error is returned if Money is nil, money.ErrRoundingNecessary
+// is returned if amount has more fraction digits than currency Fraction
+func ToMinorUnits(m *money.Money) (units int64, code string, err error) {
+	return ToMinorUnitsWithMode(m, money.Unnecessary)
+}
+
+// ToMinorUnitsWithMode returns amount in minor units and currency code like ToMinorUnits,
+// but amount is rounded by currency Fraction using the rounding mode,
+// zero mode stands for RoundingMode of Money
+func ToMinorUnitsWithMode(m *money.Money, mode money.RoundingMode) (units int64, code string, err error) {
+	if m == nil {
+		return 0, "", errNilMoney
+	}
+	if m.Currency() == nil {
+		return 0, "", fmt.Errorf("protomoney: cannot convert Money without currency")
+	}
+
+	r, err := m.RoundWithMode(int32(m.Currency().Fraction), mode)
+	if err != nil {
+		return 0, "", err
+	}
+
+	units, err = r.MinorUnits()
+	if err != nil {
+		return 0, "", err
+	}
+
+	return units, m.Currency().Code, nil
+}
+
+// FromMinorUnits converts minor units message to Money, error is returned if the message is nil
+// or currency is unknown
+func FromMinorUnits(msg MinorUnitsMessage) (*money.Money, error) {
+	if msg == nil {
+		return nil, errNilMessage
+	}
+
+	c, err := money.DefaultRegistry.Lookup(msg.GetCurrencyCode())
+	if err != nil {
+		return nil, err
+	}
+
+	return newMoney(decimal.New(msg.GetMinorUnits(), -int32(c.Fraction)), c.Code, money.Unnecessary)
+}
+
+func newMoney(amount decimal.Decimal, code string, mode money.RoundingMode) (*money.Money, error) {
+	if _, err := money.DefaultRegistry.Lookup(code); err != nil {
+		return nil, err
+	}
+
+	m, err := money.NewFromDecimalWithMode(amount, code, mode)
+	if err != nil {
+		return nil, fmt.Errorf("protomoney: %s %s: %w", amount, code, err)
+	}
+
+	return m, nil
+}
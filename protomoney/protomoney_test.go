@@ -0,0 +1,135 @@
+package protomoney_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/amanbolat/go-money"
+	"github.com/amanbolat/go-money/protomoney"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+)
+
+func TestToProto(t *testing.T) {
+	tcs := []struct {
+		money *money.Money
+		units int64
+		nanos int32
+		code  string
+	}{
+		{money.New(1234, "USD"), 12, 340000000, "USD"},
+		{money.New(-1234, "USD"), -12, -340000000, "USD"},
+		{money.New(-5, "EUR"), 0, -50000000, "EUR"},
+		{money.New(1000, "JPY"), 1000, 0, "JPY"},
+		{money.New(1, "BHD"), 0, 1000000, "BHD"},
+	}
+
+	for _, tc := range tcs {
+		pb, err := protomoney.ToProto(tc.money)
+		require.NoError(t, err)
+		assert.Equal(t, tc.units, pb.Units, tc.money.String())
+		assert.Equal(t, tc.nanos, pb.Nanos, tc.money.String())
+		assert.Equal(t, tc.code, pb.CurrencyCode)
+
+		m, err := protomoney.FromProto(pb)
+		require.NoError(t, err)
+		assert.True(t, tc.money.Amount().Equal(m.Amount()), m.String())
+		assert.Equal(t, tc.code, m.Currency().Code)
+	}
+
+}
+
+func TestNil(t *testing.T) {
+	_, err := protomoney.ToProto(nil)
+	assert.Error(t, err)
+
+	_, err = protomoney.FromProto(nil)
+	assert.Error(t, err)
+
+	_, _, err = protomoney.ToMinorUnits(nil)
+	assert.Error(t, err)
+
+	_, err = protomoney.FromMinorUnits(nil)
+	assert.Error(t, err)
+}
+
+func TestToProto_Precision(t *testing.T) {
+	m := money.New(100, "USD").Divide(3)
+
+	_, err := protomoney.ToProto(m)
+	assert.True(t, errors.Is(err, money.ErrRoundingNecessary))
+
+	pb, err := protomoney.ToProtoWithMode(m, money.Down)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), pb.Units)
+	assert.Equal(t, int32(333333333), pb.Nanos)
+
+	_, err = protomoney.ToProto(money.NewFromDecimal(decimal.New(1, 20), "JPY"))
+	assert.Error(t, err)
+}
+
+func TestFromProto(t *testing.T) {
+	tcs := []struct {
+		pb    *moneypb.Money
+		valid bool
+	}{
+		{&moneypb.Money{CurrencyCode: "USD", Units: 1, Nanos: 500000000}, true},
+		{&moneypb.Money{CurrencyCode: "USD", Units: -1, Nanos: -500000000}, true},
+		{&moneypb.Money{CurrencyCode: "USD", Units: 0, Nanos: -10000000}, true},
+		{&moneypb.Money{CurrencyCode: "USD", Units: 1, Nanos: -500000000}, false},
+		{&moneypb.Money{CurrencyCode: "USD", Units: -1, Nanos: 500000000}, false},
+		{&moneypb.Money{CurrencyCode: "USD", Nanos: 1000000000}, false},
+		{&moneypb.Money{CurrencyCode: "USD", Nanos: 5000000}, false},
+		{&moneypb.Money{CurrencyCode: "XYZ", Units: 1}, false},
+		{&moneypb.Money{CurrencyCode: "usd", Units: 1}, false},
+	}
+
+	for _, tc := range tcs {
+		_, err := protomoney.FromProto(tc.pb)
+		assert.Equal(t, tc.valid, err == nil, "%v: %v", tc.pb, err)
+	}
+
+	m, err := protomoney.FromProtoWithMode(&moneypb.Money{CurrencyCode: "USD", Nanos: 5000000}, money.HalfEven)
+	require.NoError(t, err)
+	assert.Equal(t, "0.00 USD", m.String())
+
+	var unknown *money.UnknownCurrencyError
+	_, err = protomoney.FromProto(&moneypb.Money{CurrencyCode: "XYZ"})
+	assert.True(t, errors.As(err, &unknown))
+}
+
+type amount struct {
+	CurrencyCode string
+	MinorUnits   int64
+}
+
+func (a *amount) GetCurrencyCode() string { return a.CurrencyCode }
+func (a *amount) GetMinorUnits() int64    { return a.MinorUnits }
+
+func TestMinorUnits(t *testing.T) {
+	units, code, err := protomoney.ToMinorUnits(money.New(-1234, "EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1234), units)
+	assert.Equal(t, "EUR", code)
+
+	m, err := protomoney.FromMinorUnits(&amount{CurrencyCode: code, MinorUnits: units})
+	require.NoError(t, err)
+	assert.Equal(t, "-12.34 EUR", m.String())
+
+	m, err = protomoney.FromMinorUnits(&amount{CurrencyCode: "BHD", MinorUnits: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "0.001 BHD", m.String())
+
+	third := money.New(100, "USD").Divide(3)
+	_, _, err = protomoney.ToMinorUnits(third)
+	assert.True(t, errors.Is(err, money.ErrRoundingNecessary))
+
+	units, _, err = protomoney.ToMinorUnitsWithMode(third, money.Up)
+	require.NoError(t, err)
+	assert.Equal(t, int64(34), units)
+
+	_, err = protomoney.FromMinorUnits(&amount{CurrencyCode: "XYZ", MinorUnits: 1})
+	assert.Error(t, err)
+}
@@ -0,0 +1,224 @@
+package money
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// StaleRateError is returned by RateStore when the latest rate before the requested time
+// is older than the store maximum age
+type StaleRateError struct {
+	From, To string
+	At       time.Time
+	Observed time.Time
+	MaxAge   time.Duration
+}
+
+func (e *StaleRateError) Error() string {
+	return fmt.Sprintf("money: rate %s/%s observed at %s is older than %s at %s",
+		e.From, e.To, e.Observed.Format(time.RFC3339), e.MaxAge, e.At.Format(time.RFC3339))
+}
+
+// observation is a rate of currency pair observed at the time
+type observation struct {
+	at   time.Time
+	rate decimal.Decimal
+}
+
+// RateStore is RateProvider of historical rates. Rate returns the latest rate observed
+// at or before the requested time, so conversions made in the past can be reproduced.
+// It is safe for concurrent use, Snapshot pins the current rates while updates keep arriving
+type RateStore struct {
+	mu     sync.RWMutex
+	rates  map[[2]string][]observation
+	maxAge time.Duration
+}
+
+// NewRateStore creates empty RateStore without maximum rate age
+func NewRateStore() *RateStore {
+	return &RateStore{rates: map[[2]string][]observation{}}
+}
+
+// Add stores rate of the currency pair observed at the given time, rate is the amount
+// of to currency for one unit of from currency. Rate observed at the same time is replaced
+func (s *RateStore) Add(from, to string, at time.Time, rate decimal.Decimal) error {
+	if from == to {
+		return fmt.Errorf("money: rate of %s to itself", from)
+	}
+	if rate.Sign() <= 0 {
+		return fmt.Errorf("money: rate %s of %s/%s must be positive", rate, from, to)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := [2]string{from, to}
+	obs := s.rates[key]
+	o := observation{at: at, rate: rate}
+
+	// rates usually arrive in order and are appended, snapshots share observations
+	// up to their length, so observations are copied before they are changed in place
+	i := sort.Search(len(obs), func(i int) bool { return !obs[i].at.Before(at) })
+	switch {
+	case i == len(obs):
+		obs = append(obs, o)
+	case obs[i].at.Equal(at):
+		obs = append([]observation(nil), obs...)
+		obs[i] = o
+	default:
+		obs = append(obs[:i:i], append([]observation{o}, obs[i:]...)...)
+	}
+	s.rates[key] = obs
+
+	return nil
+}
+
+// LoadCSV adds rates read from CSV with header naming time, from, to and rate columns
+// in any order, e.g.
+//
+//	time,from,to,rate
+//	2024-01-31,EUR,USD,1.0837
+//	2024-02-29T16:00:00Z,EUR,USD,1.0813
+//
+// Time is either a date which stands for midnight UTC or RFC 3339 time
+func (s *RateStore) LoadCSV(r io.Reader) error {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return fmt.Errorf("money: rates CSV: %w", err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"time", "from", "to", "rate"} {
+		if _, ok := columns[name]; !ok {
+			return fmt.Errorf("money: rates CSV has no %s column", name)
+		}
+	}
+
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("money: rates CSV: %w", err)
+		}
+
+		at, err := parseRateTime(record[columns["time"]])
+		if err != nil {
+			return fmt.Errorf("money: rates CSV line %d: %w", line, err)
+		}
+		rate, err := decimal.NewFromString(strings.TrimSpace(record[columns["rate"]]))
+		if err != nil {
+			return fmt.Errorf("money: rates CSV line %d: invalid rate %q", line, record[columns["rate"]])
+		}
+
+		from, to := strings.TrimSpace(record[columns["from"]]), strings.TrimSpace(record[columns["to"]])
+		if err := s.Add(from, to, at, rate); err != nil {
+			return fmt.Errorf("money: rates CSV line %d: %w", line, err)
+		}
+	}
+}
+
+// SetMaxAge sets maximum age of the rate relative to the requested time,
+// *StaleRateError is returned for older rates. Zero age turns the limit off
+func (s *RateStore) SetMaxAge(age time.Duration) {
+	s.mu.Lock()
+	s.maxAge = age
+	s.mu.Unlock()
+}
+
+// MaxAge returns maximum age of the rate
+func (s *RateStore) MaxAge() time.Duration {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.maxAge
+}
+
+// Snapshot returns RateStore holding current rates and maximum age which
+// is not affected by later changes of the store. It is cheap, rates are not copied
+func (s *RateStore) Snapshot() *RateStore {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	snap := &RateStore{rates: make(map[[2]string][]observation, len(s.rates)), maxAge: s.maxAge}
+	for key, obs := range s.rates {
+		snap.rates[key] = obs[:len(obs):len(obs)]
+	}
+
+	return snap
+}
+
+// Lookup returns the latest rate of the currency pair observed at or before the given time
+// along with the time it was observed. Rate of the reverse pair is inverted if it is observed later.
+// Error wrapping ErrRateNotFound or *StaleRateError is returned if there is no suitable rate
+func (s *RateStore) Lookup(from, to string, at time.Time) (decimal.Decimal, time.Time, error) {
+	if from == to {
+		return decimal.New(1, 0), at, nil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	direct, okDirect := asOf(s.rates[[2]string{from, to}], at)
+	reverse, okReverse := asOf(s.rates[[2]string{to, from}], at)
+
+	var o observation
+	switch {
+	case okDirect && (!okReverse || !reverse.at.After(direct.at)):
+		o = direct
+	case okReverse:
+		o = observation{at: reverse.at, rate: decimal.New(1, 0).Div(reverse.rate)}
+	default:
+		return decimal.Zero, time.Time{}, fmt.Errorf("%w: %s/%s at %s", ErrRateNotFound, from, to, at.Format(time.RFC3339))
+	}
+
+	if s.maxAge > 0 && at.Sub(o.at) > s.maxAge {
+		return decimal.Zero, time.Time{}, &StaleRateError{From: from, To: to, At: at, Observed: o.at, MaxAge: s.maxAge}
+	}
+
+	return o.rate, o.at, nil
+}
+
+// Rate implements RateProvider using Lookup
+func (s *RateStore) Rate(from, to *Currency, at time.Time) (decimal.Decimal, error) {
+	rate, _, err := s.Lookup(from.Code, to.Code, at)
+
+	return rate, err
+}
+
+// asOf returns the latest observation at or before the time
+func asOf(obs []observation, at time.Time) (observation, bool) {
+	i := sort.Search(len(obs), func(i int) bool { return obs[i].at.After(at) }) - 1
+	if i < 0 {
+		return observation{}, false
+	}
+
+	return obs[i], true
+}
+
+// parseRateTime reads date like "2024-01-31" as midnight UTC or RFC 3339 time
+func parseRateTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q", s)
+}
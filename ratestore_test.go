@@ -0,0 +1,162 @@
+package money_test
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/amanbolat/go-money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ratesCSV = `time,from,to,rate
+2024-01-31,EUR,USD,1.0837
+2024-02-29,EUR,USD,1.0813
+2024-03-29T16:00:00Z,EUR,USD,1.0811
+2024-02-29,USD,JPY,150.38
+`
+
+func day(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestRateStore_Lookup(t *testing.T) {
+	s := money.NewRateStore()
+	require.NoError(t, s.LoadCSV(strings.NewReader(ratesCSV)))
+
+	tcs := []struct {
+		from, to string
+		at       time.Time
+		rate     string
+		observed time.Time
+	}{
+		{"EUR", "USD", day(2024, 1, 31), "1.0837", day(2024, 1, 31)},
+		{"EUR", "USD", day(2024, 2, 15), "1.0837", day(2024, 1, 31)},
+		{"EUR", "USD", day(2024, 3, 29), "1.0813", day(2024, 2, 29)},
+		{"EUR", "USD", day(2024, 4, 30), "1.0811", time.Date(2024, 3, 29, 16, 0, 0, 0, time.UTC)},
+		{"JPY", "USD", day(2024, 3, 1), "0.0066498204548477", day(2024, 2, 29)},
+		{"USD", "USD", day(2024, 3, 1), "1", day(2024, 3, 1)},
+	}
+
+	for _, tc := range tcs {
+		rate, observed, err := s.Lookup(tc.from, tc.to, tc.at)
+		require.NoError(t, err)
+		assert.Equal(t, tc.rate, rate.String(), "%s/%s at %s", tc.from, tc.to, tc.at)
+		assert.Equal(t, tc.observed, observed)
+	}
+
+	_, _, err := s.Lookup("EUR", "USD", day(2024, 1, 30))
+	assert.True(t, errors.Is(err, money.ErrRateNotFound))
+
+	_, _, err = s.Lookup("EUR", "GBP", day(2024, 3, 1))
+	assert.True(t, errors.Is(err, money.ErrRateNotFound))
+}
+
+func TestRateStore_ReverseLater(t *testing.T) {
+	s := money.NewRateStore()
+	require.NoError(t, s.Add("EUR", "USD", day(2024, 1, 31), mustDecimal("1.25")))
+	require.NoError(t, s.Add("USD", "EUR", day(2024, 2, 29), mustDecimal("0.5")))
+
+	rate, _, err := s.Lookup("EUR", "USD", day(2024, 3, 1))
+	require.NoError(t, err)
+	assert.Equal(t, "2", rate.String())
+
+	rate, _, err = s.Lookup("EUR", "USD", day(2024, 2, 1))
+	require.NoError(t, err)
+	assert.Equal(t, "1.25", rate.String())
+}
+
+func TestRateStore_MaxAge(t *testing.T) {
+	s := money.NewRateStore()
+	require.NoError(t, s.LoadCSV(strings.NewReader(ratesCSV)))
+	s.SetMaxAge(72 * time.Hour)
+	assert.Equal(t, 72*time.Hour, s.MaxAge())
+
+	_, _, err := s.Lookup("EUR", "USD", day(2024, 3, 3))
+	assert.NoError(t, err)
+
+	_, _, err = s.Lookup("EUR", "USD", day(2024, 3, 4))
+	var stale *money.StaleRateError
+	require.True(t, errors.As(err, &stale))
+	assert.Equal(t, day(2024, 2, 29), stale.Observed)
+	assert.Equal(t, "money: rate EUR/USD observed at 2024-02-29T00:00:00Z is older than 72h0m0s at 2024-03-04T00:00:00Z", err.Error())
+}
+
+func TestRateStore_Snapshot(t *testing.T) {
+	s := money.NewRateStore()
+	require.NoError(t, s.LoadCSV(strings.NewReader(ratesCSV)))
+	snap := s.Snapshot()
+
+	require.NoError(t, s.Add("EUR", "USD", day(2024, 4, 30), mustDecimal("1.0700")))
+	require.NoError(t, s.Add("EUR", "USD", day(2024, 2, 29), mustDecimal("1.0900")))
+	require.NoError(t, s.Add("EUR", "USD", day(2024, 2, 15), mustDecimal("1.0850")))
+	require.NoError(t, snap.Add("EUR", "USD", day(2024, 5, 31), mustDecimal("1.0600")))
+
+	tcs := []struct {
+		store    *money.RateStore
+		at       time.Time
+		expected string
+	}{
+		{s, day(2024, 2, 20), "1.085"},
+		{s, day(2024, 3, 1), "1.09"},
+		{s, day(2024, 5, 1), "1.07"},
+		{s, day(2024, 6, 1), "1.07"},
+		{snap, day(2024, 2, 20), "1.0837"},
+		{snap, day(2024, 3, 1), "1.0813"},
+		{snap, day(2024, 5, 1), "1.0811"},
+		{snap, day(2024, 6, 1), "1.06"},
+	}
+
+	for _, tc := range tcs {
+		rate, _, err := tc.store.Lookup("EUR", "USD", tc.at)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, rate.String(), "%s", tc.at)
+	}
+}
+
+func TestRateStore_Concurrent(t *testing.T) {
+	s := money.NewRateStore()
+	c := money.NewConverter(s, 0)
+	require.NoError(t, s.Add("EUR", "USD", day(2024, 1, 1), mustDecimal("1.1")))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_ = s.Add("EUR", "USD", day(2024, 1, 2).Add(time.Duration(i*100+j)*time.Hour), mustDecimal("1.2"))
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_, _, err := money.New(100, "EUR").ConvertAt(money.GetCurrency("USD"), c, day(2024, 1, 1))
+				assert.NoError(t, err)
+				s.Snapshot()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRateStore_Errors(t *testing.T) {
+	s := money.NewRateStore()
+
+	assert.Error(t, s.Add("EUR", "EUR", day(2024, 1, 1), mustDecimal("1")))
+	assert.Error(t, s.Add("EUR", "USD", day(2024, 1, 1), mustDecimal("0")))
+
+	for _, data := range []string{
+		"",
+		"time,from,rate\n2024-01-31,EUR,1.08\n",
+		"time,from,to,rate\n31.01.2024,EUR,USD,1.08\n",
+		"time,from,to,rate\n2024-01-31,EUR,USD,x\n",
+		"time,from,to,rate\n2024-01-31,EUR,USD,-1\n",
+		"time,from,to,rate\n2024-01-31,EUR,USD\n",
+	} {
+		assert.Error(t, money.NewRateStore().LoadCSV(strings.NewReader(data)), data)
+	}
+}
@@ -0,0 +1,215 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Quote holds bid and ask rates of a currency pair. Bid is the amount of quote currency
+// paid for one unit of base currency, ask is the amount charged for it, so bid <= ask
+type Quote struct {
+	Bid decimal.Decimal
+	Ask decimal.Decimal
+}
+
+// Mid returns the middle rate between bid and ask
+func (q Quote) Mid() decimal.Decimal {
+	return q.Bid.Add(q.Ask).Div(decimal.New(2, 0))
+}
+
+// invert returns quote of the reverse pair, bid and ask swap sides
+func (q Quote) invert() Quote {
+	one := decimal.New(1, 0)
+
+	return Quote{Bid: one.Div(q.Ask), Ask: one.Div(q.Bid)}
+}
+
+// cross returns quote of the pair going through both quotes
+func (q Quote) cross(next Quote) Quote {
+	return Quote{Bid: q.Bid.Mul(next.Bid), Ask: q.Ask.Mul(next.Ask)}
+}
+
+// Conversion records how Money was converted by RateTable
+type Conversion struct {
+	// Source is the converted Money
+	Source *Money
+	// Target is the result of conversion
+	Target *Money
+	// Rate is the applied rate, bid rate reduced by the margin
+	Rate decimal.Decimal
+	// Path lists currency codes the rate is derived through,
+	// e.g. [GBP USD JPY] for GBP to JPY triangulated via USD
+	Path []string
+	// Margin is the table margin applied to the rate
+	Margin decimal.Decimal
+}
+
+// RateTable is RateProvider of fixed rates. It stores direct quotes, inverts them when only
+// the reverse pair is present and triangulates rates through the pivot currency when
+// the pair is missing, e.g. GBP to JPY is derived from GBP/USD and USD/JPY with USD pivot.
+// Money is converted at the bid rate reduced by the margin. It is safe for concurrent use
+type RateTable struct {
+	mu     sync.RWMutex
+	quotes map[[2]string]Quote
+	pivot  string
+	margin decimal.Decimal
+}
+
+// NewRateTable creates empty RateTable triangulating rates through the pivot currency,
+// empty pivot turns triangulation off
+func NewRateTable(pivot string) *RateTable {
+	return &RateTable{quotes: map[[2]string]Quote{}, pivot: pivot}
+}
+
+// SetRate stores rate of the currency pair without spread, rate is the amount
+// of to currency for one unit of from currency
+func (t *RateTable) SetRate(from, to string, rate decimal.Decimal) error {
+	return t.SetQuote(from, to, rate, rate)
+}
+
+// SetQuote stores bid and ask rates of the currency pair, rates must be positive and bid must not exceed ask
+func (t *RateTable) SetQuote(from, to string, bid, ask decimal.Decimal) error {
+	if from == to {
+		return fmt.Errorf("money: rate of %s to itself", from)
+	}
+	if bid.Sign() <= 0 || ask.Sign() <= 0 {
+		return fmt.Errorf("money: rates of %s/%s must be positive", from, to)
+	}
+	if bid.GreaterThan(ask) {
+		return fmt.Errorf("money: bid %s of %s/%s exceeds ask %s", bid, from, to, ask)
+	}
+
+	t.mu.Lock()
+	t.quotes[[2]string{from, to}] = Quote{Bid: bid, Ask: ask}
+	t.mu.Unlock()
+
+	return nil
+}
+
+// SetPivot sets currency rates are triangulated through, empty pivot turns triangulation off
+func (t *RateTable) SetPivot(code string) {
+	t.mu.Lock()
+	t.pivot = code
+	t.mu.Unlock()
+}
+
+// Pivot returns currency rates are triangulated through
+func (t *RateTable) Pivot() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.pivot
+}
+
+// SetMargin sets margin applied on conversion as a fraction of the rate, e.g. 0.015 for 1.5%
+func (t *RateTable) SetMargin(margin decimal.Decimal) error {
+	if margin.Sign() < 0 || margin.GreaterThanOrEqual(decimal.New(1, 0)) {
+		return fmt.Errorf("money: margin %s must be in range [0, 1)", margin)
+	}
+
+	t.mu.Lock()
+	t.margin = margin
+	t.mu.Unlock()
+
+	return nil
+}
+
+// Margin returns margin applied on conversion
+func (t *RateTable) Margin() decimal.Decimal {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.margin
+}
+
+// Quote returns quote of the currency pair along with currency codes it is derived through.
+// Error wrapping ErrRateNotFound is returned if the pair can't be derived
+func (t *RateTable) Quote(from, to string) (Quote, []string, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.quote(from, to)
+}
+
+func (t *RateTable) quote(from, to string) (Quote, []string, error) {
+	if from == to {
+		one := decimal.New(1, 0)
+		return Quote{Bid: one, Ask: one}, []string{from}, nil
+	}
+
+	if q, ok := t.direct(from, to); ok {
+		return q, []string{from, to}, nil
+	}
+
+	if t.pivot != "" && t.pivot != from && t.pivot != to {
+		first, ok := t.direct(from, t.pivot)
+		if ok {
+			if second, ok := t.direct(t.pivot, to); ok {
+				return first.cross(second), []string{from, t.pivot, to}, nil
+			}
+		}
+	}
+
+	return Quote{}, nil, fmt.Errorf("%w: %s/%s", ErrRateNotFound, from, to)
+}
+
+// direct returns stored quote of the pair or inverted quote of the reverse pair
+func (t *RateTable) direct(from, to string) (Quote, bool) {
+	if q, ok := t.quotes[[2]string{from, to}]; ok {
+		return q, true
+	}
+	if q, ok := t.quotes[[2]string{to, from}]; ok {
+		return q.invert(), true
+	}
+
+	return Quote{}, false
+}
+
+// rate returns bid rate of the pair reduced by the margin
+func (t *RateTable) rate(from, to string) (decimal.Decimal, []string, decimal.Decimal, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	q, path, err := t.quote(from, to)
+	if err != nil {
+		return decimal.Zero, nil, decimal.Zero, err
+	}
+	if from == to {
+		return q.Bid, path, decimal.Zero, nil
+	}
+
+	return q.Bid.Mul(decimal.New(1, 0).Sub(t.margin)), path, t.margin, nil
+}
+
+// Rate implements RateProvider, it returns bid rate of the pair reduced by the margin.
+// Rates of the table don't depend on time
+func (t *RateTable) Rate(from, to *Currency, _ time.Time) (decimal.Decimal, error) {
+	rate, _, _, err := t.rate(from.Code, to.Code)
+
+	return rate, err
+}
+
+// Convert returns Money converted to the currency at bid rate reduced by the margin
+// and rounded by currency Fraction using the rounding mode, zero mode stands for RoundingMode
+// of converted Money. Conversion record is returned along with the result
+func (t *RateTable) Convert(m *Money, to *Currency, mode RoundingMode) (*Money, Conversion, error) {
+	if m.currency == nil || to == nil {
+		return nil, Conversion{}, errors.New("money: cannot convert Money without currency")
+	}
+
+	rate, path, margin, err := t.rate(m.currency.Code, to.Code)
+	if err != nil {
+		return nil, Conversion{}, err
+	}
+
+	converted, err := m.exchange(to, rate, mode)
+	if err != nil {
+		return nil, Conversion{}, err
+	}
+
+	return converted, Conversion{Source: m, Target: converted, Rate: rate, Path: path, Margin: margin}, nil
+}
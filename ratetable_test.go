@@ -0,0 +1,103 @@
+package money_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/amanbolat/go-money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRateTable(t *testing.T) *money.RateTable {
+	rt := money.NewRateTable("USD")
+	require.NoError(t, rt.SetQuote("GBP", "USD", mustDecimal("1.2640"), mustDecimal("1.2660")))
+	require.NoError(t, rt.SetQuote("USD", "JPY", mustDecimal("151.30"), mustDecimal("151.40")))
+	require.NoError(t, rt.SetRate("EUR", "USD", mustDecimal("1.08")))
+
+	return rt
+}
+
+func TestRateTable_Quote(t *testing.T) {
+	rt := newRateTable(t)
+
+	tcs := []struct {
+		from, to string
+		bid, ask string
+		path     []string
+	}{
+		{"GBP", "USD", "1.264", "1.266", []string{"GBP", "USD"}},
+		{"USD", "GBP", "0.7898894154818325", "0.7911392405063291", []string{"USD", "GBP"}},
+		{"GBP", "JPY", "191.2432", "191.6724", []string{"GBP", "USD", "JPY"}},
+		{"JPY", "EUR", "0.00611575908801796279172170849846", "0.00611980122885611093975667670314", []string{"JPY", "USD", "EUR"}},
+		{"EUR", "EUR", "1", "1", []string{"EUR"}},
+	}
+
+	for _, tc := range tcs {
+		q, path, err := rt.Quote(tc.from, tc.to)
+		require.NoError(t, err, "%s/%s", tc.from, tc.to)
+		assert.Equal(t, tc.bid, q.Bid.String(), "%s/%s bid", tc.from, tc.to)
+		assert.Equal(t, tc.ask, q.Ask.String(), "%s/%s ask", tc.from, tc.to)
+		assert.Equal(t, tc.path, path)
+	}
+
+	q, _, err := rt.Quote("GBP", "USD")
+	require.NoError(t, err)
+	assert.Equal(t, "1.265", q.Mid().String())
+
+	_, _, err = rt.Quote("GBP", "CHF")
+	assert.True(t, errors.Is(err, money.ErrRateNotFound))
+
+	rt.SetPivot("")
+	assert.Equal(t, "", rt.Pivot())
+	_, _, err = rt.Quote("GBP", "JPY")
+	assert.True(t, errors.Is(err, money.ErrRateNotFound))
+}
+
+func TestRateTable_Convert(t *testing.T) {
+	rt := newRateTable(t)
+	require.NoError(t, rt.SetMargin(mustDecimal("0.01")))
+	assert.Equal(t, "0.01", rt.Margin().String())
+
+	r, c, err := rt.Convert(money.New(10000, "GBP"), money.GetCurrency("JPY"), 0)
+	require.NoError(t, err)
+	assert.Equal(t, "18933 JPY", r.String())
+	assert.Equal(t, "100.00 GBP", c.Source.String())
+	assert.Equal(t, r, c.Target)
+	assert.Equal(t, "189.330768", c.Rate.String())
+	assert.Equal(t, []string{"GBP", "USD", "JPY"}, c.Path)
+	assert.Equal(t, "0.01", c.Margin.String())
+
+	r, c, err = rt.Convert(money.New(10000, "GBP"), money.GetCurrency("GBP"), 0)
+	require.NoError(t, err)
+	assert.Equal(t, "100.00 GBP", r.String())
+	assert.True(t, c.Margin.IsZero())
+
+	_, _, err = rt.Convert(money.New(10000, "GBP"), money.GetCurrency("USD"), money.Unnecessary)
+	assert.True(t, errors.Is(err, money.ErrRoundingNecessary))
+}
+
+func TestRateTable_Converter(t *testing.T) {
+	c := money.NewConverter(newRateTable(t), money.Down)
+
+	r, rate, err := money.New(10000, "JPY").Convert(money.GetCurrency("GBP"), c)
+	require.NoError(t, err)
+	assert.Equal(t, "52.17 GBP", r.String())
+	assert.Equal(t, "0.0052172352409631908662906083505", rate.String())
+
+	rate, err = newRateTable(t).Rate(money.GetCurrency("EUR"), money.GetCurrency("USD"), time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, "1.08", rate.String())
+}
+
+func TestRateTable_Errors(t *testing.T) {
+	rt := money.NewRateTable("USD")
+
+	assert.Error(t, rt.SetRate("USD", "USD", mustDecimal("1")))
+	assert.Error(t, rt.SetRate("EUR", "USD", mustDecimal("0")))
+	assert.Error(t, rt.SetQuote("EUR", "USD", mustDecimal("1.09"), mustDecimal("1.08")))
+	assert.Error(t, rt.SetQuote("EUR", "USD", mustDecimal("-1"), mustDecimal("1.08")))
+	assert.Error(t, rt.SetMargin(mustDecimal("-0.01")))
+	assert.Error(t, rt.SetMargin(mustDecimal("1")))
+}
@@ -0,0 +1,49 @@
+package money
+
+import (
+	"fmt"
+	"time"
+)
+
+// Redenominate returns Money converted to the currency valid at the given time
+// using the default registry, see Registry.Redenominate
+func Redenominate(m *Money, at time.Time) (*Money, error) {
+	return DefaultRegistry.Redenominate(m, at)
+}
+
+// Redenominate returns Money converted to the currency valid at the given time.
+// Money of withdrawn currency is divided by the official rate of its successor and rounded
+// by successor Fraction using RoundingMode of the result, successors are followed until a valid currency is found,
+// e.g. LTL becomes EUR after 2015-01-01. Money of valid currency is returned as is.
+// *ValidityError is returned if currency is not valid yet or is withdrawn without successor
+func (r *Registry) Redenominate(m *Money, at time.Time) (*Money, error) {
+	c, amount := m.currency, m.amount
+
+	seen := map[string]bool{}
+	for {
+		err := c.CheckValidity(at)
+		if err == nil {
+			break
+		}
+		if c.Successor == nil || c.ValidUntil.IsZero() || at.Before(c.ValidUntil) {
+			return nil, err
+		}
+		if seen[c.Code] {
+			return nil, fmt.Errorf("money: redenomination cycle at currency %s", c.Code)
+		}
+		seen[c.Code] = true
+
+		next, err := r.Lookup(c.Successor.Code)
+		if err != nil {
+			return nil, err
+		}
+
+		mode := (&Money{currency: next, rounding: m.rounding}).RoundingMode()
+		if amount, err = divide(amount, c.Successor.Rate, int32(next.Fraction), mode); err != nil {
+			return nil, err
+		}
+		c = next
+	}
+
+	return &Money{amount: amount, currency: c, rounding: m.rounding}, nil
+}
@@ -0,0 +1,90 @@
+package money_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/amanbolat/go-money"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedenominate(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		at       time.Time
+		expected string
+	}{
+		{10000, "LTL", time.Date(2015, 6, 1, 0, 0, 0, 0, time.UTC), "28.96 EUR"},
+		{10000, "LTL", time.Date(2014, 12, 31, 0, 0, 0, 0, time.UTC), "100 LTL"},
+		{1500000, "TRL", time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC), "1.5 TRY"},
+		{1500000, "TRL", time.Date(2005, 6, 1, 0, 0, 0, 0, time.UTC), "1500000 TRL"},
+		{100000, "EEK", time.Date(2011, 2, 1, 0, 0, 0, 0, time.UTC), "63.91 EUR"},
+		{1234, "EUR", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), "12.34 EUR"},
+		{1234, "USD", time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC), "12.34 USD"},
+	}
+
+	for _, tc := range tcs {
+		m, err := money.Redenominate(money.New(tc.amount, tc.code), tc.at)
+		if assert.NoError(t, err) {
+			assert.Equal(t, tc.expected, m.Amount().String()+" "+m.Currency().Code)
+		}
+	}
+}
+
+func TestRedenominate_Validity(t *testing.T) {
+	_, err := money.Redenominate(money.New(100, "EUR"), time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC))
+	if assert.IsType(t, &money.ValidityError{}, err) {
+		assert.Equal(t, "money: currency EUR is valid from 1999-01-01, used at 1998-01-01", err.Error())
+	}
+
+	_, err = money.Redenominate(money.New(100, "ZWD"), time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	if assert.IsType(t, &money.ValidityError{}, err) {
+		assert.Equal(t, "money: currency ZWD is withdrawn since 2006-09-01, used at 2020-01-01", err.Error())
+	}
+}
+
+func TestRegistry_Redenominate(t *testing.T) {
+	r := money.NewEmptyRegistry()
+	r.Register(money.Currency{Code: "AAA", Fraction: 2, ValidUntil: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successor: &money.Redenomination{Code: "BBB", Rate: decimal.New(1000, 0)}})
+	r.Register(money.Currency{Code: "BBB", Fraction: 2, ValidUntil: time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successor: &money.Redenomination{Code: "CCC", Rate: decimal.New(100, 0)}})
+	r.Register(money.Currency{Code: "CCC", Fraction: 2})
+	r.Register(money.Currency{Code: "DDD", Fraction: 2, ValidUntil: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successor: &money.Redenomination{Code: "XXX", Rate: decimal.New(10, 0)}})
+	r.Register(money.Currency{Code: "EEE", Fraction: 2, ValidFrom: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successor: &money.Redenomination{Code: "CCC", Rate: decimal.New(10, 0)}})
+
+	m, err := r.Redenominate(r.New(123456789, "AAA"), time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "CCC", m.Currency().Code)
+		assert.Equal(t, "12.35", m.Amount().String())
+	}
+
+	_, err = r.Redenominate(r.New(100, "DDD"), time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.IsType(t, &money.UnknownCurrencyError{}, err)
+
+	_, err = r.Redenominate(r.New(100, "EEE"), time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.IsType(t, &money.ValidityError{}, err)
+}
+
+func TestCurrency_MarshalJSON(t *testing.T) {
+	b, err := json.Marshal(money.Currency{Code: "TNT", Fraction: 2, Decimal: ".", Thousand: ","})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"code":"TNT","fraction":2,"grapheme":"","template":"","decimal":".","thousand":","}`, string(b))
+	}
+
+	b, err = json.Marshal(money.Currency{Code: "TNT", ValidUntil: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)})
+	if assert.NoError(t, err) {
+		assert.Contains(t, string(b), `"valid_until":"2000-01-01T00:00:00Z"`)
+		assert.NotContains(t, string(b), "valid_from")
+
+		var c money.Currency
+		if assert.NoError(t, json.Unmarshal(b, &c)) {
+			assert.True(t, c.ValidUntil.Equal(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)))
+		}
+	}
+}
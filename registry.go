@@ -0,0 +1,258 @@
+package money
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/shopspring/decimal"
+)
+
+// Registry holds currency definitions used to create Money.
+// It is safe for concurrent use, so currencies can be registered at runtime
+// while other goroutines create Money. Currencies returned by Registry must not be modified
+type Registry struct {
+	mu         sync.RWMutex
+	currencies map[string]*Currency
+	numeric    map[string]*Currency
+	strict     bool
+	rounding   RoundingMode
+}
+
+// DefaultRegistry is used by package level functions like New, AddCurrency and GetCurrency
+var DefaultRegistry = NewRegistry()
+
+// NewRegistry creates Registry with the built-in currencies list.
+// Currencies added to the registry are isolated from other registries
+func NewRegistry() *Registry {
+	r := NewEmptyRegistry()
+	for _, c := range currencies {
+		r.set(c)
+	}
+
+	return r
+}
+
+// NewEmptyRegistry creates Registry without any currencies
+func NewEmptyRegistry() *Registry {
+	return &Registry{currencies: map[string]*Currency{}, numeric: map[string]*Currency{}}
+}
+
+// AddCurrency lets you insert or update currency in the registry
+func (r *Registry) AddCurrency(Code, Grapheme, Template, Decimal, Thousand string, Fraction int) *Currency {
+	c := &Currency{
+		Code:     Code,
+		Grapheme: Grapheme,
+		Template: Template,
+		Decimal:  Decimal,
+		Thousand: Thousand,
+		Fraction: Fraction,
+	}
+
+	r.mu.Lock()
+	r.set(c)
+	r.mu.Unlock()
+
+	return c
+}
+
+// Register lets you insert or update currency with all its fields, e.g. NumericCode and Name
+func (r *Registry) Register(c Currency) *Currency {
+	r.mu.Lock()
+	r.set(&c)
+	r.mu.Unlock()
+
+	return &c
+}
+
+// set stores currency and updates numeric code index, active currencies
+// take precedence over historic ones sharing the same numeric code
+func (r *Registry) set(c *Currency) {
+	if old, ok := r.currencies[c.Code]; ok && r.numeric[old.NumericCode] == old {
+		delete(r.numeric, old.NumericCode)
+	}
+
+	r.currencies[c.Code] = c
+
+	if c.NumericCode == "" {
+		return
+	}
+	if old, ok := r.numeric[c.NumericCode]; ok && !old.Historic && c.Historic {
+		return
+	}
+	r.numeric[c.NumericCode] = c
+}
+
+// SetStrict turns strict mode on or off. Strict registry refuses codes which are not registered
+// instead of falling back to the default currency wherever an error can be returned, so
+// NewFromDecimalWithMode, UnmarshalJSON, UnmarshalBinary and Scan fail for unknown codes.
+// New and NewFromDecimal can't return an error and keep falling back to the default currency,
+// use NewStrict and NewFromDecimalStrict to validate codes regardless of the mode
+func (r *Registry) SetStrict(strict bool) {
+	r.mu.Lock()
+	r.strict = strict
+	r.mu.Unlock()
+}
+
+// Strict reports whether registry is in strict mode
+func (r *Registry) Strict() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.strict
+}
+
+// SetRounding sets default rounding mode of Money created by the registry,
+// it is used for currencies without own Rounding. Zero mode stands for HalfUp.
+// Operations without error return like NewFromDecimal and Round use HalfUp instead of Unnecessary
+func (r *Registry) SetRounding(mode RoundingMode) {
+	r.mu.Lock()
+	r.rounding = mode
+	r.mu.Unlock()
+}
+
+// Rounding returns default rounding mode of the registry
+func (r *Registry) Rounding() RoundingMode {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.rounding
+}
+
+// GetCurrency returns the currency given the code or nil if it is not registered
+func (r *Registry) GetCurrency(code string) *Currency {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.currencies[code]
+}
+
+// GetCurrencyByNumeric returns the currency given ISO 4217 numeric code, e.g. "978" or "36",
+// or nil if it is not registered
+func (r *Registry) GetCurrencyByNumeric(numeric string) *Currency {
+	for len(numeric) < 3 {
+		numeric = "0" + numeric
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.numeric[numeric]
+}
+
+// Currencies returns all registered currencies sorted by code
+func (r *Registry) Currencies() []*Currency {
+	r.mu.RLock()
+	list := make([]*Currency, 0, len(r.currencies))
+	for _, c := range r.currencies {
+		list = append(list, c)
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(list, func(i, j int) bool { return list[i].Code < list[j].Code })
+
+	return list
+}
+
+// New creates and returns new instance of Money using registry currencies,
+// amount should be in cents for currency. Unknown codes fall back to the default
+// currency even in strict mode, use NewStrict to refuse them
+func (r *Registry) New(amount int64, code string) *Money {
+	c := r.get(code)
+
+	return &Money{
+		amount:   decimal.New(amount, -int32(c.Fraction)),
+		currency: c,
+		rounding: r.Rounding(),
+	}
+}
+
+// NewFromDecimal creates Money instance from decimal.Decimal using registry currencies
+// and rounds it by currency Fraction using the default rounding mode, HalfUp is used if
+// the mode is Unnecessary. Unknown codes fall back to the default currency even in strict mode,
+// use NewFromDecimalStrict to refuse them
+func (r *Registry) NewFromDecimal(amount decimal.Decimal, code string) *Money {
+	c := r.get(code)
+	m := &Money{amount: amount, currency: c, rounding: r.Rounding()}
+
+	return m.Round(int32(c.Fraction))
+}
+
+// NewFromDecimalWithMode creates Money instance from decimal.Decimal using registry currencies
+// and rounds it by currency Fraction using the rounding mode, zero mode stands for the default one.
+// Error is returned if registry is strict and currency is unknown or rounding fails
+func (r *Registry) NewFromDecimalWithMode(amount decimal.Decimal, code string, mode RoundingMode) (*Money, error) {
+	c, err := r.resolve(code)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.newMoney(amount, c, mode)
+}
+
+// NewStrict creates Money instance like New, but returns error
+// if currency is not registered or its code is malformed
+func (r *Registry) NewStrict(amount int64, code string) (*Money, error) {
+	c, err := r.Lookup(code)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{
+		amount:   decimal.New(amount, -int32(c.Fraction)),
+		currency: c,
+		rounding: r.Rounding(),
+	}, nil
+}
+
+// NewFromDecimalStrict creates Money instance like NewFromDecimal, but returns error
+// if currency is not registered or its code is malformed
+func (r *Registry) NewFromDecimalStrict(amount decimal.Decimal, code string) (*Money, error) {
+	c, err := r.Lookup(code)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.newMoney(amount, c, 0)
+}
+
+// newMoney creates Money of the currency rounded by currency Fraction,
+// zero mode stands for currency Rounding or the registry default
+func (r *Registry) newMoney(amount decimal.Decimal, c *Currency, mode RoundingMode) (*Money, error) {
+	m := &Money{amount: amount, currency: c, rounding: r.Rounding()}
+
+	return m.RoundWithMode(int32(c.Fraction), mode)
+}
+
+// Lookup returns registered currency given the exact code,
+// *InvalidCodeError or *UnknownCurrencyError is returned otherwise
+func (r *Registry) Lookup(code string) (*Currency, error) {
+	if c := r.GetCurrency(code); c != nil {
+		return c, nil
+	}
+
+	if err := ValidateCode(code); err != nil {
+		return nil, err
+	}
+
+	return nil, &UnknownCurrencyError{Code: code}
+}
+
+// resolve returns currency for functions returning an error, unknown codes
+// fall back to the default currency unless registry is strict
+func (r *Registry) resolve(code string) (*Currency, error) {
+	if r.Strict() {
+		return r.Lookup(code)
+	}
+
+	return r.get(code), nil
+}
+
+// get returns registered currency or the default one if currency is not found
+func (r *Registry) get(code string) *Currency {
+	c := newCurrency(code)
+	if curr := r.GetCurrency(c.Code); curr != nil {
+		return curr
+	}
+
+	return c.getDefault()
+}
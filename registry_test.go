@@ -0,0 +1,155 @@
+package money_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/amanbolat/go-money"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry_Isolation(t *testing.T) {
+	r := money.NewRegistry()
+	r.AddCurrency("TNT", "T$", "$1", ".", ",", 3)
+
+	assert.NotNil(t, r.GetCurrency("TNT"))
+	assert.Nil(t, money.GetCurrency("TNT"))
+	assert.NotNil(t, r.GetCurrency("EUR"))
+
+	m := r.New(1234, "TNT")
+	assert.Equal(t, 3, m.Currency().Fraction)
+	assert.Equal(t, "T$1.234", m.Display())
+
+	m = money.New(1234, "TNT")
+	assert.Equal(t, 2, m.Currency().Fraction)
+}
+
+func TestRegistry_NewEmptyRegistry(t *testing.T) {
+	r := money.NewEmptyRegistry()
+
+	assert.Nil(t, r.GetCurrency("EUR"))
+	assert.Empty(t, r.Currencies())
+}
+
+func TestRegistry_NewFromDecimal(t *testing.T) {
+	r := money.NewEmptyRegistry()
+	r.AddCurrency("TNT", "T$", "$1", ".", ",", 1)
+
+	m := r.NewFromDecimal(decimal.New(1255, -3), "tnt")
+	assert.Equal(t, "TNT", m.Currency().Code)
+	assert.True(t, m.Amount().Equal(decimal.New(13, -1)), "got %s", m.Amount().String())
+}
+
+func TestRegistry_Currencies(t *testing.T) {
+	r := money.NewEmptyRegistry()
+	r.AddCurrency("BBB", "B", "$1", ".", ",", 2)
+	r.AddCurrency("AAA", "A", "$1", ".", ",", 2)
+
+	cs := r.Currencies()
+	if assert.Len(t, cs, 2) {
+		assert.Equal(t, "AAA", cs[0].Code)
+		assert.Equal(t, "BBB", cs[1].Code)
+	}
+}
+
+func TestRegistry_Parse(t *testing.T) {
+	r := money.NewEmptyRegistry()
+	r.AddCurrency("TNT", "T$", "$1", ".", ",", 2)
+
+	m, err := r.Parse("T$1,000.50", nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "TNT", m.Currency().Code)
+		assert.True(t, m.Amount().Equal(decimal.New(100050, -2)))
+	}
+
+	_, err = r.Parse("€1.00", nil)
+	assert.Error(t, err)
+}
+
+func TestRegistry_Concurrency(t *testing.T) {
+	r := money.NewRegistry()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			r.AddCurrency(fmt.Sprintf("T%02d", i), "T", "$1", ".", ",", 2)
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			r.New(100, fmt.Sprintf("T%02d", i)).Display()
+			r.Currencies()
+		}(i)
+	}
+	wg.Wait()
+
+	assert.NotNil(t, r.GetCurrency("T09"))
+}
+
+func TestRegistry_NewStrict(t *testing.T) {
+	r := money.NewRegistry()
+	r.AddCurrency("GOLD", "G", "$1", ".", ",", 0)
+
+	m, err := r.NewStrict(100, "EUR")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "EUR", m.Currency().Code)
+	}
+
+	m, err = r.NewStrict(100, "GOLD")
+	if assert.NoError(t, err) {
+		assert.Equal(t, 0, m.Currency().Fraction)
+	}
+
+	_, err = r.NewStrict(100, "UDS")
+	assert.IsType(t, &money.UnknownCurrencyError{}, err)
+
+	_, err = r.NewFromDecimalStrict(decimal.New(1, 0), "usd")
+	assert.IsType(t, &money.InvalidCodeError{}, err)
+
+	_, err = r.NewFromDecimalStrict(decimal.New(1, 0), "SILVER")
+	assert.IsType(t, &money.InvalidCodeError{}, err)
+}
+
+func TestRegistry_Lookup(t *testing.T) {
+	r := money.NewRegistry()
+
+	c, err := r.Lookup("EUR")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "EUR", c.Code)
+	}
+
+	_, err = r.Lookup("UDS")
+	assert.IsType(t, &money.UnknownCurrencyError{}, err)
+
+	_, err = r.Lookup("eur")
+	assert.IsType(t, &money.InvalidCodeError{}, err)
+}
+
+func TestRegistry_SetStrict(t *testing.T) {
+	r := money.NewRegistry()
+	assert.False(t, r.Strict())
+
+	assert.Equal(t, "UDS", r.New(100, "UDS").Currency().Code)
+
+	r.SetStrict(true)
+	assert.True(t, r.Strict())
+	assert.NotPanics(t, func() {
+		assert.Equal(t, "UDS", r.New(100, "UDS").Currency().Code)
+		assert.Equal(t, "USD", r.NewFromDecimal(decimal.New(1, 0), "usd").Currency().Code)
+	})
+
+	_, err := r.NewStrict(100, "UDS")
+	assert.IsType(t, &money.UnknownCurrencyError{}, err)
+
+	_, err = r.NewFromDecimalWithMode(decimal.New(1, 0), "UDS", 0)
+	assert.IsType(t, &money.UnknownCurrencyError{}, err)
+
+	r.SetStrict(false)
+	m, err := r.NewFromDecimalWithMode(decimal.New(1, 0), "UDS", 0)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "UDS", m.Currency().Code)
+	}
+}
@@ -0,0 +1,135 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// RoundingMode specifies how amounts are rounded when precision is lost.
+// Zero RoundingMode is the default mode: currency Rounding is used if set,
+// then rounding of the registry Money was created by, then HalfUp
+type RoundingMode int
+
+const (
+	// HalfUp rounds towards the nearest neighbour, ties away from zero: 2.5 -> 3, -2.5 -> -3
+	HalfUp RoundingMode = iota + 1
+	// HalfDown rounds towards the nearest neighbour, ties towards zero: 2.5 -> 2, -2.5 -> -2
+	HalfDown
+	// HalfEven rounds towards the nearest neighbour, ties to the even one: 2.5 -> 2, 3.5 -> 4.
+	// It is also known as banker's rounding
+	HalfEven
+	// Up rounds away from zero: 2.1 -> 3, -2.1 -> -3
+	Up
+	// Down rounds towards zero: 2.9 -> 2, -2.9 -> -2
+	Down
+	// Ceiling rounds towards positive infinity: 2.1 -> 3, -2.9 -> -2
+	Ceiling
+	// Floor rounds towards negative infinity: 2.9 -> 2, -2.1 -> -3
+	Floor
+	// Unnecessary asserts that amount is exact, ErrRoundingNecessary is returned otherwise
+	Unnecessary
+)
+
+// ErrRoundingNecessary is returned when Unnecessary rounding mode is used
+// and amount can't be represented without rounding
+var ErrRoundingNecessary = errors.New("money: rounding necessary")
+
+var roundingModeNames = map[RoundingMode]string{
+	HalfUp:      "HalfUp",
+	HalfDown:    "HalfDown",
+	HalfEven:    "HalfEven",
+	Up:          "Up",
+	Down:        "Down",
+	Ceiling:     "Ceiling",
+	Floor:       "Floor",
+	Unnecessary: "Unnecessary",
+}
+
+func (mode RoundingMode) String() string {
+	if name, ok := roundingModeNames[mode]; ok {
+		return name
+	}
+	if mode == 0 {
+		return "Default"
+	}
+
+	return fmt.Sprintf("RoundingMode(%d)", int(mode))
+}
+
+// lenient returns rounding mode for operations which can't return an error,
+// Unnecessary and unknown modes fall back to HalfUp
+func lenient(mode RoundingMode) RoundingMode {
+	if _, ok := roundingModeNames[mode]; !ok || mode == Unnecessary {
+		return HalfUp
+	}
+
+	return mode
+}
+
+// round returns amount rounded to scale decimal places using rounding mode,
+// negative scale rounds the integer part, e.g. to tens
+func round(amount decimal.Decimal, scale int32, mode RoundingMode) (decimal.Decimal, error) {
+	if mode == 0 || mode == HalfUp {
+		return amount.Round(scale), nil
+	}
+
+	down := amount.Shift(scale).Truncate(0).Shift(-scale)
+	if down.Equal(amount) {
+		return amount.Round(scale), nil
+	}
+
+	half := amount.Sub(down).Abs().Cmp(decimal.New(5, -scale-1))
+
+	return adjust(down, amount.Sign(), half, scale, mode)
+}
+
+// divide returns amount divided by div and rounded to scale decimal places using rounding mode.
+// Unlike decimal.Div the quotient is exact, so ties are detected correctly
+func divide(amount, div decimal.Decimal, scale int32, mode RoundingMode) (decimal.Decimal, error) {
+	if div.IsZero() {
+		return decimal.Zero, errors.New("money: division by zero")
+	}
+
+	quo, rem := amount.QuoRem(div, scale)
+	if rem.IsZero() {
+		return quo.Round(scale), nil
+	}
+
+	half := rem.Abs().Mul(decimal.New(2, 0)).Cmp(div.Abs().Shift(-scale))
+
+	return adjust(quo, amount.Sign()*div.Sign(), half, scale, mode)
+}
+
+// adjust rounds inexact value truncated towards zero to down. Sign is the sign of the value
+// and half is the result of comparing the dropped part with half of the last digit unit
+func adjust(down decimal.Decimal, sign, half int, scale int32, mode RoundingMode) (decimal.Decimal, error) {
+	var away bool
+	switch mode {
+	case 0, HalfUp:
+		away = half >= 0
+	case HalfDown:
+		away = half > 0
+	case HalfEven:
+		away = half > 0 || (half == 0 && !down.Shift(scale).Mod(decimal.New(2, 0)).IsZero())
+	case Up:
+		away = true
+	case Down:
+		away = false
+	case Ceiling:
+		away = sign > 0
+	case Floor:
+		away = sign < 0
+	case Unnecessary:
+		return decimal.Zero, ErrRoundingNecessary
+	default:
+		return decimal.Zero, fmt.Errorf("money: unknown rounding mode %s", mode)
+	}
+
+	if away {
+		down = down.Add(decimal.New(int64(sign), -scale))
+	}
+
+	return down.Round(scale), nil
+}
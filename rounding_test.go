@@ -0,0 +1,193 @@
+package money_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/amanbolat/go-money"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_RoundWithMode(t *testing.T) {
+	modes := []money.RoundingMode{money.HalfUp, money.HalfDown, money.HalfEven, money.Up, money.Down, money.Ceiling, money.Floor}
+
+	tcs := []struct {
+		amount   string
+		expected []string
+	}{
+		{"5.5", []string{"6", "5", "6", "6", "5", "6", "5"}},
+		{"2.5", []string{"3", "2", "2", "3", "2", "3", "2"}},
+		{"1.6", []string{"2", "2", "2", "2", "1", "2", "1"}},
+		{"1.1", []string{"1", "1", "1", "2", "1", "2", "1"}},
+		{"1.0", []string{"1", "1", "1", "1", "1", "1", "1"}},
+		{"-1.0", []string{"-1", "-1", "-1", "-1", "-1", "-1", "-1"}},
+		{"-1.1", []string{"-1", "-1", "-1", "-2", "-1", "-1", "-2"}},
+		{"-1.6", []string{"-2", "-2", "-2", "-2", "-1", "-1", "-2"}},
+		{"-2.5", []string{"-3", "-2", "-2", "-3", "-2", "-2", "-3"}},
+		{"-5.5", []string{"-6", "-5", "-6", "-6", "-5", "-5", "-6"}},
+	}
+
+	for _, tc := range tcs {
+		m := money.NewFromDecimal(decimal.RequireFromString(tc.amount), "EUR")
+		for i, mode := range modes {
+			r, err := m.RoundWithMode(0, mode)
+			if assert.NoError(t, err) {
+				assert.Truef(t, r.Amount().Equal(decimal.RequireFromString(tc.expected[i])),
+					"Expected %s rounded %s to be %s got %s", tc.amount, mode, tc.expected[i], r.Amount())
+			}
+		}
+	}
+}
+
+func TestMoney_RoundWithMode_Unnecessary(t *testing.T) {
+	m := money.New(1250, "EUR")
+
+	r, err := m.RoundWithMode(1, money.Unnecessary)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "12.5", r.Amount().String())
+	}
+
+	_, err = m.RoundWithMode(0, money.Unnecessary)
+	assert.Equal(t, money.ErrRoundingNecessary, err)
+
+	_, err = m.RoundWithMode(-1, money.Floor)
+	assert.NoError(t, err)
+}
+
+func TestMoney_DivideWithMode(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		divisor  int64
+		mode     money.RoundingMode
+		expected int64
+	}{
+		{10, 4, money.HalfUp, 3},
+		{10, 4, money.HalfEven, 2},
+		{10, 4, money.HalfDown, 2},
+		{-10, 4, money.HalfEven, -2},
+		{30, 4, money.HalfEven, 8},
+		{10, 3, money.Up, 4},
+		{10, -3, money.Ceiling, -3},
+		{10, -3, money.Floor, -4},
+		{10, 5, money.Unnecessary, 2},
+		// quotient is slightly above the tie, decimal.Div precision would lose it
+		{1000000000000000001, 2000000000000000000, money.HalfEven, 1},
+	}
+
+	for _, tc := range tcs {
+		m := money.New(tc.amount, "EUR")
+		r, err := m.DivideWithMode(tc.divisor, tc.mode)
+		if assert.NoError(t, err) {
+			assert.Truef(t, r.Amount().Equal(decimal.New(tc.expected, -2)), "Expected %d / %d %s = %d got %s",
+				tc.amount, tc.divisor, tc.mode, tc.expected, r.Amount())
+		}
+	}
+
+	_, err := money.New(10, "EUR").DivideWithMode(3, money.Unnecessary)
+	assert.Equal(t, money.ErrRoundingNecessary, err)
+
+	_, err = money.New(10, "EUR").DivideWithMode(0, money.HalfUp)
+	assert.Error(t, err)
+}
+
+func TestMoney_MultiplyWithMode(t *testing.T) {
+	m := money.NewFromDecimal(decimal.RequireFromString("0.125"), "EUR").Divide(2)
+
+	r, err := m.MultiplyWithMode(3, money.Down)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "0.19", r.Amount().String())
+	}
+}
+
+func TestNewFromDecimalWithMode(t *testing.T) {
+	m, err := money.NewFromDecimalWithMode(decimal.RequireFromString("1.005"), "EUR", money.HalfEven)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "1", m.Amount().String())
+	}
+
+	_, err = money.NewFromDecimalWithMode(decimal.RequireFromString("1.005"), "EUR", money.Unnecessary)
+	assert.Equal(t, money.ErrRoundingNecessary, err)
+}
+
+func TestRegistry_SetRounding(t *testing.T) {
+	r := money.NewEmptyRegistry()
+	r.AddCurrency("TNT", "T$", "$1", ".", ",", 0)
+	r.Register(money.Currency{Code: "TNU", Fraction: 0, Rounding: money.Floor})
+	r.SetRounding(money.HalfEven)
+
+	assert.Equal(t, money.HalfEven, r.Rounding())
+
+	m := r.NewFromDecimal(decimal.RequireFromString("2.5"), "TNT")
+	assert.Equal(t, "2", m.Amount().String())
+	assert.Equal(t, money.HalfEven, m.RoundingMode())
+
+	half, err := r.New(5, "TNT").DivideWithMode(2, 0)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "2", half.Amount().String())
+	}
+
+	m = r.NewFromDecimal(decimal.RequireFromString("2.9"), "TNU")
+	assert.Equal(t, "2", m.Amount().String())
+
+	r.SetRounding(money.Unnecessary)
+	assert.NotPanics(t, func() {
+		assert.Equal(t, "3", r.NewFromDecimal(decimal.RequireFromString("2.5"), "TNT").Amount().String())
+		assert.Equal(t, "3", r.New(5, "TNT").Divide(2).Round(0).Amount().String())
+	})
+	_, err = r.NewFromDecimalWithMode(decimal.RequireFromString("2.5"), "TNT", 0)
+	assert.Equal(t, money.ErrRoundingNecessary, err)
+	_, err = r.New(5, "TNT").Divide(2).RoundWithMode(0, 0)
+	assert.Equal(t, money.ErrRoundingNecessary, err)
+
+	r.SetRounding(money.RoundingMode(42))
+	assert.Equal(t, "3", r.NewFromDecimal(decimal.RequireFromString("2.5"), "TNT").Amount().String())
+
+	assert.Equal(t, money.HalfUp, money.New(1, "EUR").RoundingMode())
+}
+
+func TestRoundingMode_Operations(t *testing.T) {
+	r := money.NewEmptyRegistry()
+	r.AddCurrency("TNT", "T$", "$1", ".", ",", 2)
+	r.Register(money.Currency{Code: "TNO", Fraction: 2, ValidUntil: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successor: &money.Redenomination{Code: "TNT", Rate: decimal.New(3, 0)}})
+
+	tcs := []struct {
+		mode     money.RoundingMode
+		divide   string
+		allocate []string
+		redenom  string
+	}{
+		{money.HalfUp, "0.6666666666666667", []string{"0.34", "0.33", "0.33"}, "0.67"},
+		{money.Down, "0.6666666666666666", []string{"0.34", "0.33", "0.33"}, "0.66"},
+		{money.Up, "0.6666666666666667", []string{"0.33", "0.33", "0.34"}, "0.67"},
+		{money.Unnecessary, "0.6666666666666667", []string{"0.34", "0.33", "0.33"}, ""},
+	}
+
+	for _, tc := range tcs {
+		r.SetRounding(tc.mode)
+
+		assert.Equal(t, tc.divide, r.New(200, "TNT").Divide(3).Amount().String(), "Divide %s", tc.mode)
+
+		parties, err := r.New(100, "TNT").Allocate(1, 1, 1)
+		if assert.NoError(t, err) {
+			var got []string
+			for _, p := range parties {
+				got = append(got, p.Amount().String())
+			}
+			assert.Equal(t, tc.allocate, got, "Allocate %s", tc.mode)
+		}
+
+		m, err := r.Redenominate(r.New(200, "TNO"), time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+		if tc.redenom == "" {
+			assert.Equal(t, money.ErrRoundingNecessary, err)
+			continue
+		}
+		if assert.NoError(t, err) {
+			assert.Equal(t, tc.redenom, m.Amount().String(), "Redenominate %s", tc.mode)
+		}
+	}
+
+	_, err := r.New(100, "TNT").Allocate(0, 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,257 @@
+package money
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// Value implements driver.Valuer, currency is stored as its ISO code
+func (c Currency) Value() (driver.Value, error) {
+	return c.Code, nil
+}
+
+// Scan implements sql.Scanner, currency is read from its ISO code using the default registry
+func (c *Currency) Scan(src interface{}) error {
+	code, err := scanString(src)
+	if err != nil {
+		return err
+	}
+
+	curr, err := DefaultRegistry.resolve(code)
+	if err != nil {
+		return err
+	}
+	*c = *curr
+
+	return nil
+}
+
+// Value implements driver.Valuer, Money is stored in a single text column as "12.34 USD"
+func (m Money) Value() (driver.Value, error) {
+	if m.currency == nil {
+		return nil, errors.New("money: cannot store Money without currency")
+	}
+
+	return m.text(), nil
+}
+
+// Scan implements sql.Scanner, Money is read from a text column like "12.34 USD"
+// using the default registry. Amount is not rounded. Use NullMoney for nullable columns
+func (m *Money) Scan(src interface{}) error {
+	s, err := scanString(src)
+	if err != nil {
+		return err
+	}
+
+	mm, err := DefaultRegistry.parseText(s)
+	if err != nil {
+		return err
+	}
+	*m = *mm
+
+	return nil
+}
+
+// MinorUnits returns amount in minor units of the currency, e.g. 1234 for 12.34 USD.
+// ErrRoundingNecessary is returned if amount has more digits than currency Fraction
+func (m *Money) MinorUnits() (int64, error) {
+	return minorUnits(m.amount, m.currency.Fraction, m.currency.Code)
+}
+
+func minorUnits(amount decimal.Decimal, fraction int, code string) (int64, error) {
+	units := amount.Shift(int32(fraction))
+	if !units.Equal(units.Truncate(0)) {
+		return 0, ErrRoundingNecessary
+	}
+	if units.GreaterThan(decimal.New(math.MaxInt64, 0)) || units.LessThan(decimal.New(math.MinInt64, 0)) {
+		return 0, fmt.Errorf("money: %s %s overflows minor units", amount, code)
+	}
+
+	return units.IntPart(), nil
+}
+
+// NullMoney represents Money that may be NULL, it is stored in a text column like Money.
+// NullMoney mirrors sql.NullString
+type NullMoney struct {
+	Money Money
+	Valid bool
+}
+
+// Scan implements sql.Scanner
+func (n *NullMoney) Scan(src interface{}) error {
+	if src == nil {
+		n.Money, n.Valid = Money{}, false
+		return nil
+	}
+
+	n.Valid = true
+	return n.Money.Scan(src)
+}
+
+// Value implements driver.Valuer
+func (n NullMoney) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Money.Value()
+}
+
+// DecimalColumns stores Money in NUMERIC amount and currency code columns.
+// Scan into Dest and pass Args as query arguments, NULL amount and currency represent NULL Money:
+//
+//	var c money.DecimalColumns
+//	err := db.QueryRow("SELECT amount, currency FROM payments").Scan(c.Dest()...)
+//	m, err := c.Money()
+type DecimalColumns struct {
+	Amount   decimal.NullDecimal
+	Currency sql.NullString
+}
+
+// NewDecimalColumns returns DecimalColumns of Money, nil Money is stored as NULL
+func NewDecimalColumns(m *Money) DecimalColumns {
+	if m == nil {
+		return DecimalColumns{}
+	}
+
+	return DecimalColumns{
+		Amount:   decimal.NullDecimal{Decimal: m.amount, Valid: true},
+		Currency: sql.NullString{String: m.currency.Code, Valid: true},
+	}
+}
+
+// Dest returns pointers to the columns for sql.Row.Scan
+func (c *DecimalColumns) Dest() []interface{} {
+	return []interface{}{&c.Amount, &c.Currency}
+}
+
+// Args returns column values for query arguments
+func (c DecimalColumns) Args() []interface{} {
+	return []interface{}{c.Amount, c.Currency}
+}
+
+// Money returns Money of the columns using the default registry,
+// nil is returned if both columns are NULL. Amount is not rounded like Money Scan does
+func (c DecimalColumns) Money() (*Money, error) {
+	if !c.Amount.Valid && !c.Currency.Valid {
+		return nil, nil
+	}
+	if !c.Amount.Valid || !c.Currency.Valid {
+		return nil, errors.New("money: amount and currency columns must be both NULL or both set")
+	}
+
+	cur, err := DefaultRegistry.resolve(c.Currency.String)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: c.Amount.Decimal, currency: cur, rounding: DefaultRegistry.Rounding()}, nil
+}
+
+// MinorColumns stores Money in BIGINT amount column holding minor units and currency code column.
+// It is used like DecimalColumns
+type MinorColumns struct {
+	Amount   sql.NullInt64
+	Currency sql.NullString
+}
+
+// NewMinorColumns returns MinorColumns of Money, nil Money is stored as NULL.
+// ErrRoundingNecessary is returned if amount has more digits than currency Fraction
+func NewMinorColumns(m *Money) (MinorColumns, error) {
+	if m == nil {
+		return MinorColumns{}, nil
+	}
+
+	units, err := m.MinorUnits()
+	if err != nil {
+		return MinorColumns{}, err
+	}
+
+	return MinorColumns{
+		Amount:   sql.NullInt64{Int64: units, Valid: true},
+		Currency: sql.NullString{String: m.currency.Code, Valid: true},
+	}, nil
+}
+
+// Dest returns pointers to the columns for sql.Row.Scan
+func (c *MinorColumns) Dest() []interface{} {
+	return []interface{}{&c.Amount, &c.Currency}
+}
+
+// Args returns column values for query arguments
+func (c MinorColumns) Args() []interface{} {
+	return []interface{}{c.Amount, c.Currency}
+}
+
+// Money returns Money of the columns using the default registry,
+// nil is returned if both columns are NULL
+func (c MinorColumns) Money() (*Money, error) {
+	if !c.Amount.Valid && !c.Currency.Valid {
+		return nil, nil
+	}
+	if !c.Amount.Valid || !c.Currency.Valid {
+		return nil, errors.New("money: amount and currency columns must be both NULL or both set")
+	}
+
+	cur, err := DefaultRegistry.resolve(c.Currency.String)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{
+		amount:   decimal.New(c.Amount.Int64, -int32(cur.Fraction)),
+		currency: cur,
+		rounding: DefaultRegistry.Rounding(),
+	}, nil
+}
+
+// text returns Money as amount with at least currency Fraction digits and currency code,
+// e.g. "12.30 USD". Amount is not rounded, so extra fraction digits are kept
+func (m *Money) text() string {
+	amount := m.amount.String()
+	if i := strings.IndexByte(amount, '.'); i < 0 || len(amount)-i-1 <= m.currency.Fraction {
+		amount = m.amount.StringFixed(int32(m.currency.Fraction))
+	}
+
+	return amount + " " + m.currency.Code
+}
+
+// parseText reads Money written by text. Amount is kept exactly as written,
+// so Money with more digits than currency Fraction survives the round trip
+func (r *Registry) parseText(s string) (*Money, error) {
+	parts := strings.Split(s, " ")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("money: invalid money %q, expected amount and currency code like \"12.34 USD\"", s)
+	}
+
+	amount, err := decimal.NewFromString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("money: invalid amount in %q: %v", s, err)
+	}
+
+	c, err := r.resolve(parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: amount, currency: c, rounding: r.Rounding()}, nil
+}
+
+func scanString(src interface{}) (string, error) {
+	switch v := src.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case nil:
+		return "", errors.New("money: cannot scan NULL")
+	}
+
+	return "", fmt.Errorf("money: cannot scan %T", src)
+}
@@ -0,0 +1,162 @@
+package money_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/amanbolat/go-money"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ sql.Scanner   = &money.Money{}
+	_ driver.Valuer = money.Money{}
+	_ sql.Scanner   = &money.Currency{}
+	_ driver.Valuer = money.Currency{}
+	_ sql.Scanner   = &money.NullMoney{}
+	_ driver.Valuer = money.NullMoney{}
+)
+
+func TestCurrency_SQL(t *testing.T) {
+	v, err := money.GetCurrency("EUR").Value()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "EUR", v)
+	}
+
+	var c money.Currency
+	if assert.NoError(t, c.Scan([]byte("JPY"))) {
+		assert.Equal(t, "JPY", c.Code)
+		assert.Equal(t, 0, c.Fraction)
+	}
+
+	assert.Error(t, c.Scan(nil))
+	assert.Error(t, c.Scan(12))
+}
+
+func TestMoney_SQL(t *testing.T) {
+	v, err := money.New(1230, "USD").Value()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "12.30 USD", v)
+	}
+
+	var m money.Money
+	if assert.NoError(t, m.Scan("-12.5 USD")) {
+		assert.Equal(t, "-12.5", m.Amount().String())
+		assert.Equal(t, "USD", m.Currency().Code)
+	}
+
+	assert.NoError(t, m.Scan([]byte("1000 JPY")))
+	assert.Equal(t, "1000", m.Amount().String())
+
+	for _, src := range []interface{}{nil, "12.34", "12.34  USD", "abc USD", 1234} {
+		assert.Error(t, m.Scan(src), "%v", src)
+	}
+
+	_, err = money.Money{}.Value()
+	assert.Error(t, err)
+}
+
+func TestMoney_SQLExtraPrecision(t *testing.T) {
+	in := money.New(100, "USD").Divide(8)
+
+	v, err := in.Value()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "0.125 USD", v)
+
+	var out money.Money
+	if assert.NoError(t, out.Scan(v)) {
+		assert.True(t, in.Amount().Equal(out.Amount()), "%s != %s", in.Amount(), out.Amount())
+	}
+
+	var n money.NullMoney
+	if assert.NoError(t, n.Scan([]byte("1.0005 EUR"))) {
+		assert.Equal(t, "1.0005", n.Money.Amount().String())
+	}
+}
+
+func TestNullMoney(t *testing.T) {
+	var n money.NullMoney
+	if assert.NoError(t, n.Scan(nil)) {
+		assert.False(t, n.Valid)
+	}
+
+	v, err := n.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	if assert.NoError(t, n.Scan("1.99 EUR")) {
+		assert.True(t, n.Valid)
+		assert.Equal(t, "1.99", n.Money.Amount().String())
+	}
+
+	v, err = n.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "1.99 EUR", v)
+}
+
+func TestDecimalColumns(t *testing.T) {
+	c := money.NewDecimalColumns(money.New(1234, "EUR"))
+	args := c.Args()
+	if assert.Len(t, args, 2) {
+		amount, _ := args[0].(driver.Valuer).Value()
+		code, _ := args[1].(driver.Valuer).Value()
+		assert.Equal(t, "12.34", amount)
+		assert.Equal(t, "EUR", code)
+	}
+
+	var scanned money.DecimalColumns
+	dest := scanned.Dest()
+	assert.NoError(t, dest[0].(sql.Scanner).Scan([]byte("56.789")))
+	assert.NoError(t, dest[1].(sql.Scanner).Scan("USD"))
+
+	m, err := scanned.Money()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "56.789", m.Amount().String())
+	}
+
+	eighth := money.New(100, "USD").Divide(8)
+	m, err = money.NewDecimalColumns(eighth).Money()
+	if assert.NoError(t, err) {
+		assert.True(t, eighth.Amount().Equal(m.Amount()), "%s", m.Amount())
+	}
+
+	m, err = money.NewDecimalColumns(nil).Money()
+	assert.NoError(t, err)
+	assert.Nil(t, m)
+
+	_, err = money.DecimalColumns{Amount: decimal.NullDecimal{Valid: true}}.Money()
+	assert.Error(t, err)
+}
+
+func TestMinorColumns(t *testing.T) {
+	c, err := money.NewMinorColumns(money.New(-1234, "EUR"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(-1234), c.Amount.Int64)
+		assert.Equal(t, "EUR", c.Currency.String)
+	}
+
+	m, err := c.Money()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "-12.34", m.Amount().String())
+	}
+
+	var scanned money.MinorColumns
+	dest := scanned.Dest()
+	assert.NoError(t, dest[0].(sql.Scanner).Scan(int64(500)))
+	assert.NoError(t, dest[1].(sql.Scanner).Scan("JPY"))
+
+	m, err = scanned.Money()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "500", m.Amount().String())
+	}
+
+	_, err = money.NewMinorColumns(money.New(1, "EUR").Divide(2))
+	assert.Equal(t, money.ErrRoundingNecessary, err)
+
+	_, err = money.NewMinorColumns(money.NewFromDecimal(decimal.New(1, 30), "EUR"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,123 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// String returns canonical representation of Money, e.g. "12.34 USD"
+func (m Money) String() string {
+	if m.currency == nil {
+		return m.amount.String()
+	}
+
+	return m.text()
+}
+
+// MarshalText implements encoding.TextMarshaler using canonical representation like "12.34 USD"
+func (m Money) MarshalText() ([]byte, error) {
+	if m.currency == nil {
+		return nil, errors.New("money: cannot marshal Money without currency")
+	}
+
+	return []byte(m.text()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler reading canonical representation
+// like "12.34 USD" using the default registry, amount is kept exactly as written
+func (m *Money) UnmarshalText(text []byte) error {
+	mm, err := DefaultRegistry.parseText(string(text))
+	if err != nil {
+		return err
+	}
+	*m = *mm
+
+	return nil
+}
+
+// Format implements fmt.Formatter. Supported verbs are:
+//
+//	%v  canonical representation, e.g. 12.34 USD
+//	%s  Display result, e.g. $12.34
+//	%q  quoted canonical representation
+//	%d  amount in minor units rounded by RoundingMode, e.g. 1234
+//	%f  amount with currency Fraction digits or the given precision rounded by RoundingMode,
+//	    e.g. %.3f gives 12.340
+//
+// Flag '+' forces the sign of positive amounts, width and flags '-' and '0' pad the result
+func (m Money) Format(f fmt.State, verb rune) {
+	var s string
+	switch verb {
+	case 'v':
+		s = m.String()
+	case 's':
+		s = m.String()
+		if m.currency != nil {
+			s = m.Display()
+		}
+	case 'q':
+		s = strconv.Quote(m.String())
+	case 'd':
+		s = m.formatMinorUnits()
+	case 'f':
+		fraction := 0
+		if m.currency != nil {
+			fraction = m.currency.Fraction
+		}
+		if p, ok := f.Precision(); ok {
+			fraction = p
+		}
+		s = m.roundedAmount(fraction).StringFixed(int32(fraction))
+	default:
+		fmt.Fprintf(f, "%%!%c(money.Money=%s)", verb, m.String())
+		return
+	}
+
+	if f.Flag('+') && m.amount.Sign() >= 0 && verb != 'q' {
+		s = "+" + s
+	}
+
+	width, ok := f.Width()
+	if !ok || len([]rune(s)) >= width {
+		_, _ = f.Write([]byte(s))
+		return
+	}
+
+	padding := width - len([]rune(s))
+	switch {
+	case f.Flag('-'):
+		s += strings.Repeat(" ", padding)
+	case f.Flag('0') && (verb == 'd' || verb == 'f'):
+		sign := ""
+		if s[0] == '+' || s[0] == '-' {
+			sign, s = s[:1], s[1:]
+		}
+		s = sign + strings.Repeat("0", padding) + s
+	default:
+		s = strings.Repeat(" ", padding) + s
+	}
+
+	_, _ = f.Write([]byte(s))
+}
+
+// formatMinorUnits returns amount in minor units rounded by RoundingMode of Money
+func (m Money) formatMinorUnits() string {
+	fraction := 0
+	if m.currency != nil {
+		fraction = m.currency.Fraction
+	}
+
+	return m.roundedAmount(fraction).Shift(int32(fraction)).String()
+}
+
+// roundedAmount returns amount rounded to scale decimal places by RoundingMode of Money,
+// HalfUp is used if the mode is Unnecessary
+func (m Money) roundedAmount(scale int) decimal.Decimal {
+	amount, _ := round(m.amount, int32(scale), lenient(m.RoundingMode()))
+
+	return amount
+}
@@ -0,0 +1,112 @@
+package money_test
+
+import (
+	"encoding"
+	"fmt"
+	"testing"
+
+	"github.com/amanbolat/go-money"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ fmt.Stringer             = money.Money{}
+	_ fmt.Formatter            = money.Money{}
+	_ encoding.TextMarshaler   = money.Money{}
+	_ encoding.TextUnmarshaler = &money.Money{}
+)
+
+func TestMoney_String(t *testing.T) {
+	assert.Equal(t, "12.30 USD", money.New(1230, "USD").String())
+	assert.Equal(t, "-5 JPY", money.New(-5, "JPY").String())
+	assert.Equal(t, "0.125 USD", money.New(25, "USD").Divide(2).String())
+	assert.Equal(t, "0", money.Money{}.String())
+}
+
+func TestMoney_Text(t *testing.T) {
+	b, err := money.New(1234, "USD").MarshalText()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "12.34 USD", string(b))
+	}
+
+	_, err = money.Money{}.MarshalText()
+	assert.Error(t, err)
+
+	var m money.Money
+	if assert.NoError(t, m.UnmarshalText([]byte("-0.5 EUR"))) {
+		assert.Equal(t, "-0.5", m.Amount().String())
+		assert.Equal(t, "EUR", m.Currency().Code)
+	}
+
+	assert.Error(t, m.UnmarshalText([]byte("EUR 0.5")))
+}
+
+func TestMoney_TextRoundTrip(t *testing.T) {
+	for _, in := range []*money.Money{money.New(25, "USD").Divide(2), money.New(100, "USD").Divide(3), money.New(5, "JPY").Divide(4)} {
+		b, err := in.MarshalText()
+		if !assert.NoError(t, err) {
+			continue
+		}
+
+		var out money.Money
+		if assert.NoError(t, out.UnmarshalText(b)) {
+			assert.True(t, in.Amount().Equal(out.Amount()), "%s != %s", in.Amount(), out.Amount())
+			assert.Equal(t, in.String(), out.String())
+		}
+	}
+
+	var m money.Money
+	if assert.NoError(t, m.UnmarshalText([]byte("0.125 USD"))) {
+		assert.Equal(t, "0.125 USD", m.String())
+	}
+}
+
+func TestMoney_Formatter(t *testing.T) {
+	usd := money.New(1234, "USD")
+	neg := money.New(-1234, "USD")
+
+	tcs := []struct {
+		format   string
+		value    interface{}
+		expected string
+	}{
+		{"%v", usd, "12.34 USD"},
+		{"%v", *usd, "12.34 USD"},
+		{"%+v", usd, "+12.34 USD"},
+		{"%+v", neg, "-12.34 USD"},
+		{"%s", usd, "$12.34"},
+		{"%+s", usd, "+$12.34"},
+		{"%s", neg, "-$12.34"},
+		{"%q", usd, `"12.34 USD"`},
+		{"%d", usd, "1234"},
+		{"%+d", usd, "+1234"},
+		{"%d", usd.Divide(8), "154"},
+		{"%f", usd, "12.34"},
+		{"%.3f", usd, "12.340"},
+		{"%.0f", usd, "12"},
+		{"%+.1f", neg, "-12.3"},
+		{"%8d", usd, "    1234"},
+		{"%-8d|", usd, "1234    |"},
+		{"%08d", neg, "-0001234"},
+		{"%x", usd, "%!x(money.Money=12.34 USD)"},
+		{"%v", money.NewFromDecimal(decimal.New(5, 0), "JPY"), "5 JPY"},
+	}
+
+	for _, tc := range tcs {
+		assert.Equal(t, tc.expected, fmt.Sprintf(tc.format, tc.value), tc.format)
+	}
+}
+
+func TestMoney_FormatterRounding(t *testing.T) {
+	r := money.NewRegistry()
+	r.SetRounding(money.HalfEven)
+	m := r.New(100, "USD").Divide(8)
+
+	assert.Equal(t, "12", fmt.Sprintf("%d", m))
+	assert.Equal(t, "0.12", fmt.Sprintf("%f", m))
+	assert.Equal(t, "0.2", fmt.Sprintf("%.1f", r.New(25, "USD")))
+
+	r.SetRounding(money.Unnecessary)
+	assert.Equal(t, "0.13", fmt.Sprintf("%f", r.New(100, "USD").Divide(8)))
+}
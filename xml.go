@@ -0,0 +1,104 @@
+package money
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// ISO 20022 ActiveOrHistoricCurrencyAndAmount limits
+const (
+	xmlTotalDigits    = 18
+	xmlFractionDigits = 5
+)
+
+// MarshalXML implements xml.Marshaler using ISO 20022 amount element form
+// with currency code in Ccy attribute, e.g. <InstdAmt Ccy="EUR">123.45</InstdAmt>.
+// Amount must be non-negative and have at most 18 digits and 5 fraction digits,
+// it must not have more fraction digits than currency Fraction, so UnmarshalXML can read it back
+func (m Money) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if m.currency == nil {
+		return fmt.Errorf("money: cannot marshal Money without currency")
+	}
+	if err := validateXMLAmount(m.amount); err != nil {
+		return err
+	}
+	if _, err := m.RoundWithMode(int32(m.currency.Fraction), Unnecessary); err != nil {
+		return fmt.Errorf("money: amount %s has more fraction digits than %s allows: %w", m.amount, m.currency.Code, err)
+	}
+
+	amount := m.text()
+	amount = amount[:strings.IndexByte(amount, ' ')]
+
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "Ccy"}, Value: m.currency.Code})
+
+	return e.EncodeElement(amount, start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler reading ISO 20022 amount element form.
+// Amount is validated like MarshalXML does and must not have more fraction digits than
+// currency Fraction, currency is looked up in the default registry and must be registered
+func (m *Money) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+
+	var code string
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "Ccy" {
+			code = attr.Value
+		}
+	}
+	if code == "" {
+		return fmt.Errorf("money: %s element has no Ccy attribute", start.Name.Local)
+	}
+
+	c, err := DefaultRegistry.Lookup(code)
+	if err != nil {
+		return err
+	}
+
+	s = strings.TrimSpace(s)
+	amount, err := decimal.NewFromString(s)
+	if err != nil || strings.ContainsAny(s, "eE") {
+		return fmt.Errorf("money: invalid amount %q in %s element", s, start.Name.Local)
+	}
+	if err := validateXMLAmount(amount); err != nil {
+		return err
+	}
+
+	mm, err := DefaultRegistry.newMoney(amount, c, Unnecessary)
+	if err != nil {
+		return fmt.Errorf("money: amount %q in %s element has more fraction digits than %s allows: %w",
+			s, start.Name.Local, c.Code, err)
+	}
+	*m = *mm
+
+	return nil
+}
+
+// validateXMLAmount checks ISO 20022 ActiveOrHistoricCurrencyAndAmount limits,
+// digits are counted without leading and trailing zeros like XML Schema does
+func validateXMLAmount(amount decimal.Decimal) error {
+	if amount.Sign() < 0 {
+		return fmt.Errorf("money: ISO 20022 amount %s must not be negative", amount)
+	}
+
+	integer, fraction := amount.String(), ""
+	if i := strings.IndexByte(integer, '.'); i >= 0 {
+		integer, fraction = integer[:i], integer[i+1:]
+	}
+	integer = strings.TrimLeft(integer, "0")
+
+	if len(fraction) > xmlFractionDigits {
+		return fmt.Errorf("money: ISO 20022 amount %s has more than %d fraction digits", amount, xmlFractionDigits)
+	}
+	if len(integer)+len(fraction) > xmlTotalDigits {
+		return fmt.Errorf("money: ISO 20022 amount %s has more than %d digits", amount, xmlTotalDigits)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package money_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"testing"
+
+	"github.com/amanbolat/go-money"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type transfer struct {
+	XMLName  xml.Name     `xml:"CdtTrfTxInf"`
+	InstdAmt money.Money  `xml:"Amt>InstdAmt"`
+	ChrgsAmt *money.Money `xml:"ChrgsInf>Amt,omitempty"`
+}
+
+func marshalXML(m *money.Money) ([]byte, error) {
+	var buf bytes.Buffer
+	err := xml.NewEncoder(&buf).EncodeElement(m, xml.StartElement{Name: xml.Name{Local: "InstdAmt"}})
+
+	return buf.Bytes(), err
+}
+
+func TestMoney_MarshalXML(t *testing.T) {
+	tcs := []struct {
+		money    *money.Money
+		expected string
+	}{
+		{money.New(12345, "EUR"), `<InstdAmt Ccy="EUR">123.45</InstdAmt>`},
+		{money.New(100, "JPY"), `<InstdAmt Ccy="JPY">100</InstdAmt>`},
+		{money.New(1, "BHD"), `<InstdAmt Ccy="BHD">0.001</InstdAmt>`},
+		{money.New(0, "USD"), `<InstdAmt Ccy="USD">0.00</InstdAmt>`},
+	}
+
+	for _, tc := range tcs {
+		b, err := marshalXML(tc.money)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, string(b))
+	}
+}
+
+func TestMoney_MarshalXMLLimits(t *testing.T) {
+	tcs := []struct {
+		money *money.Money
+		valid bool
+	}{
+		{money.NewFromDecimal(decimal.RequireFromString("9999999999999999.99"), "EUR"), true},
+		{money.NewFromDecimal(decimal.RequireFromString("99999999999999999.99"), "EUR"), false},
+		{money.NewFromDecimal(decimal.RequireFromString("0.125"), "BHD"), true},
+		{money.New(100, "USD").Divide(8), false},
+		{money.New(100, "USD").Divide(64), false},
+		{money.New(-100, "EUR"), false},
+	}
+
+	for _, tc := range tcs {
+		_, err := marshalXML(tc.money)
+		assert.Equal(t, tc.valid, err == nil, "%s: %v", tc.money, err)
+	}
+
+	_, err := marshalXML(&money.Money{})
+	assert.Error(t, err)
+}
+
+func TestMoney_UnmarshalXML(t *testing.T) {
+	data := `<CdtTrfTxInf>
+		<Amt><InstdAmt Ccy="EUR"> 123.45 </InstdAmt></Amt>
+		<ChrgsInf><Amt Ccy="USD">0.5</Amt></ChrgsInf>
+	</CdtTrfTxInf>`
+
+	var tr transfer
+	require.NoError(t, xml.Unmarshal([]byte(data), &tr))
+	assert.Equal(t, "123.45 EUR", tr.InstdAmt.String())
+	assert.Equal(t, "0.50 USD", tr.ChrgsAmt.String())
+
+	b, err := xml.Marshal(tr)
+	require.NoError(t, err)
+	assert.Equal(t, `<CdtTrfTxInf><Amt><InstdAmt Ccy="EUR">123.45</InstdAmt></Amt>`+
+		`<ChrgsInf><Amt Ccy="USD">0.50</Amt></ChrgsInf></CdtTrfTxInf>`, string(b))
+}
+
+func TestMoney_UnmarshalXMLErrors(t *testing.T) {
+	tcs := []string{
+		`<InstdAmt>1.00</InstdAmt>`,
+		`<InstdAmt Ccy="XYZ">1.00</InstdAmt>`,
+		`<InstdAmt Ccy="eur">1.00</InstdAmt>`,
+		`<InstdAmt Ccy="EUR">abc</InstdAmt>`,
+		`<InstdAmt Ccy="EUR">1e3</InstdAmt>`,
+		`<InstdAmt Ccy="EUR">-1.00</InstdAmt>`,
+		`<InstdAmt Ccy="EUR">1.123456</InstdAmt>`,
+		`<InstdAmt Ccy="EUR">1234567890123456789</InstdAmt>`,
+	}
+
+	for _, data := range tcs {
+		var m money.Money
+		assert.Error(t, xml.Unmarshal([]byte(data), &m), data)
+	}
+
+	var m money.Money
+	assert.NoError(t, xml.Unmarshal([]byte(`<InstdAmt Ccy="EUR">000123.45000</InstdAmt>`), &m))
+	assert.Equal(t, "123.45 EUR", m.String())
+
+	err := xml.Unmarshal([]byte(`<InstdAmt Ccy="EUR">1.12345</InstdAmt>`), &m)
+	assert.True(t, errors.Is(err, money.ErrRoundingNecessary), "%v", err)
+
+	err = xml.Unmarshal([]byte(`<InstdAmt Ccy="JPY">100.5</InstdAmt>`), &m)
+	assert.True(t, errors.Is(err, money.ErrRoundingNecessary), "%v", err)
+}